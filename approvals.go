@@ -0,0 +1,184 @@
+package harvest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ApprovalGroup is the time and expenses one user has submitted for one
+// week that are waiting for approval.
+type ApprovalGroup struct {
+	User *User
+	// PeriodStart is the first day of the week, which starts on the
+	// account's week start day.
+	PeriodStart Date
+	TimeEntries []TimeEntry
+	Expenses    []Expense
+	// Hours is the total hours of TimeEntries.
+	Hours decimal.Decimal
+}
+
+// UserApprovalSummary is a user's hours by approval status.
+type UserApprovalSummary struct {
+	User        *User
+	Unsubmitted decimal.Decimal
+	Submitted   decimal.Decimal
+	Approved    decimal.Decimal
+}
+
+// Total returns the user's hours across all statuses.
+func (s UserApprovalSummary) Total() decimal.Decimal {
+	return s.Unsubmitted.Add(s.Submitted).Add(s.Approved)
+}
+
+// ListPendingApproval returns the time entries spent from from to to that
+// are submitted and waiting for approval, which Harvest lists with
+// approval_status=submitted.
+func (s *TimeEntriesService) ListPendingApproval(ctx context.Context, from, to Date) ([]TimeEntry, error) {
+	return s.ListBetween(ctx, from, to, &TimeEntryListOptions{ApprovalStatus: ApprovalStatusSubmitted})
+}
+
+// ListPendingApproval returns the expenses spent from from to to that are
+// submitted and waiting for approval.
+func (s *ExpensesService) ListPendingApproval(ctx context.Context, from, to Date) ([]Expense, error) {
+	return s.ListBetween(ctx, from, to, &ExpenseListOptions{ApprovalStatus: ApprovalStatusSubmitted})
+}
+
+// PendingApprovals lists the time entries and expenses spent between from
+// and to that are submitted but not yet approved, grouped by user and week.
+// Groups are ordered by user name, then by week.
+//
+// Experimental: this API may change in minor releases.
+func (c *API) PendingApprovals(ctx context.Context, from, to Date) ([]ApprovalGroup, error) {
+	company, err := c.Company.Cached(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.TimeEntries.ListPendingApproval(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	expenses, err := c.Expenses.ListPendingApproval(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		user  UserID
+		start time.Time
+	}
+	weekStart := weekday(company.WeekStartDay)
+	groups := make(map[key]*ApprovalGroup)
+	group := func(u *User, spent Date) *ApprovalGroup {
+		start := startOfWeek(spent, weekStart)
+		k := key{start: start.Time}
+		if u != nil {
+			k.user = u.ID
+		}
+		g, ok := groups[k]
+		if !ok {
+			g = &ApprovalGroup{User: u, PeriodStart: start}
+			groups[k] = g
+		}
+		return g
+	}
+	for _, e := range entries {
+		g := group(e.User, e.SpentDate)
+		g.TimeEntries = append(g.TimeEntries, e)
+		g.Hours = g.Hours.Add(e.Hours)
+	}
+	for _, e := range expenses {
+		g := group(e.User, e.SpentDate)
+		g.Expenses = append(g.Expenses, e)
+	}
+
+	result := make([]ApprovalGroup, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, *g)
+	}
+	slices.SortFunc(result, func(a, b ApprovalGroup) int {
+		return cmp.Or(compareUsers(a.User, b.User), a.PeriodStart.Compare(b.PeriodStart.Time))
+	})
+	return result, nil
+}
+
+// ApprovalSummary totals the hours each user spent between from and to by
+// approval status. Users are ordered by name.
+//
+// Experimental: this API may change in minor releases.
+func (c *API) ApprovalSummary(ctx context.Context, from, to Date) ([]UserApprovalSummary, error) {
+	entries, err := c.TimeEntries.ListBetween(ctx, from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[UserID]*UserApprovalSummary)
+	for _, e := range entries {
+		var id UserID
+		if e.User != nil {
+			id = e.User.ID
+		}
+		s, ok := summaries[id]
+		if !ok {
+			s = &UserApprovalSummary{User: e.User}
+			summaries[id] = s
+		}
+		switch e.ApprovalStatus {
+		case ApprovalStatusApproved:
+			s.Approved = s.Approved.Add(e.Hours)
+		case ApprovalStatusSubmitted:
+			s.Submitted = s.Submitted.Add(e.Hours)
+		default:
+			s.Unsubmitted = s.Unsubmitted.Add(e.Hours)
+		}
+	}
+
+	result := make([]UserApprovalSummary, 0, len(summaries))
+	for _, s := range summaries {
+		result = append(result, *s)
+	}
+	slices.SortFunc(result, func(a, b UserApprovalSummary) int {
+		return compareUsers(a.User, b.User)
+	})
+	return result, nil
+}
+
+// weekday converts an account's week start day to a time.Weekday,
+// defaulting to Monday.
+func weekday(d WeekStartDay) time.Weekday {
+	switch d {
+	case WeekStartSaturday:
+		return time.Saturday
+	case WeekStartSunday:
+		return time.Sunday
+	default:
+		return time.Monday
+	}
+}
+
+// startOfWeek returns the first day of the week containing d for weeks
+// starting on start.
+func startOfWeek(d Date, start time.Weekday) Date {
+	return d.AddDays(-((int(d.Weekday()) - int(start) + 7) % 7))
+}
+
+// compareUsers orders users by name, then ID, with nil users last.
+func compareUsers(a, b *User) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return cmp.Or(
+		strings.Compare(strings.ToLower(userFullName(a)), strings.ToLower(userFullName(b))),
+		cmp.Compare(a.ID, b.ID),
+	)
+}
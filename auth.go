@@ -0,0 +1,224 @@
+package harvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultClockSkew is how long before its expiry a token is treated as
+// expired, tolerating clock differences between the client and Harvest ID.
+const DefaultClockSkew = 30 * time.Second
+
+const harvestIDTokenURL = "https://id.getharvest.com/api/v2/oauth2/token"
+
+// ErrReauthRequired is returned when a refresh token has been revoked or has
+// expired and the user must authorize the application again.
+var ErrReauthRequired = errors.New("harvest: re-authorization required")
+
+// Token is an OAuth2 token issued by Harvest ID.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Expiry       time.Time `json:"expiry,omitzero"`
+}
+
+// TokenSource supplies access tokens for API requests.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenRefresher exchanges a refresh token for a new token. It should return
+// an error wrapping ErrReauthRequired when the refresh token is no longer valid,
+// and must return a token when it returns no error.
+type TokenRefresher func(ctx context.Context, refreshToken string) (*Token, error)
+
+// WithTokenSource authenticates requests with tokens from ts instead of a
+// fixed access token.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *API) {
+		c.tokenSource = ts
+	}
+}
+
+// RefreshingTokenSource is a TokenSource that refreshes its token shortly
+// before expiry. Concurrent callers that find the token expired share a
+// single refresh. Once the refresh token is rejected, every call returns
+// ErrReauthRequired.
+//
+// Experimental: this API may change in minor releases.
+type RefreshingTokenSource struct {
+	refresh TokenRefresher
+	skew    time.Duration
+
+	mu       sync.Mutex
+	token    *Token
+	inflight *refreshCall
+	reauth   error
+}
+
+type refreshCall struct {
+	done  chan struct{}
+	token *Token
+	err   error
+}
+
+// NewRefreshingTokenSource returns a RefreshingTokenSource starting from token.
+// A token is refreshed once it is within skew of its expiry; a skew of zero
+// uses DefaultClockSkew.
+func NewRefreshingTokenSource(token *Token, refresh TokenRefresher, skew time.Duration) *RefreshingTokenSource {
+	if skew == 0 {
+		skew = DefaultClockSkew
+	}
+	return &RefreshingTokenSource{
+		refresh: refresh,
+		skew:    skew,
+		token:   token,
+	}
+}
+
+// Token returns a valid token, refreshing it if necessary.
+func (s *RefreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	if s.reauth != nil {
+		s.mu.Unlock()
+		return nil, s.reauth
+	}
+	if s.valid(s.token) {
+		token := s.token
+		s.mu.Unlock()
+		return token, nil
+	}
+
+	call := s.inflight
+	if call == nil {
+		call = &refreshCall{done: make(chan struct{})}
+		s.inflight = call
+		var refreshToken string
+		if s.token != nil {
+			refreshToken = s.token.RefreshToken
+		}
+		// The refresh outlives the caller that triggered it so that one
+		// cancelled request doesn't fail the others waiting on it.
+		go s.doRefresh(context.WithoutCancel(ctx), call, refreshToken)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *RefreshingTokenSource) valid(token *Token) bool {
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+	return token.Expiry.IsZero() || time.Now().Add(s.skew).Before(token.Expiry)
+}
+
+func (s *RefreshingTokenSource) doRefresh(ctx context.Context, call *refreshCall, refreshToken string) {
+	var token *Token
+	var err error
+	if refreshToken == "" {
+		err = fmt.Errorf("%w: no refresh token available", ErrReauthRequired)
+	} else {
+		token, err = s.refresh(ctx, refreshToken)
+		if err == nil && token == nil {
+			err = errors.New("harvest: token refresher returned no token")
+		}
+	}
+
+	s.mu.Lock()
+	if err == nil {
+		if token.RefreshToken == "" {
+			token.RefreshToken = refreshToken
+		}
+		s.token = token
+	} else if errors.Is(err, ErrReauthRequired) {
+		s.reauth = err
+	}
+	s.inflight = nil
+	s.mu.Unlock()
+
+	call.token, call.err = token, err
+	close(call.done)
+}
+
+// HarvestIDRefresher returns a TokenRefresher that exchanges refresh tokens
+// with Harvest ID using the application's client credentials. Applications
+// authorized with Login and no secret pass an empty clientSecret.
+// A nil httpClient uses a client with the default timeout.
+//
+// Experimental: this API may change in minor releases.
+func HarvestIDRefresher(clientID, clientSecret string, httpClient *http.Client) TokenRefresher {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return func(ctx context.Context, refreshToken string) (*Token, error) {
+		form := url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refreshToken},
+			"client_id":     {clientID},
+		}
+		if clientSecret != "" {
+			form.Set("client_secret", clientSecret)
+		}
+		return requestToken(ctx, httpClient, form)
+	}
+}
+
+// requestToken posts form to the Harvest ID token endpoint.
+func requestToken(ctx context.Context, httpClient *http.Client, form url.Values) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", harvestIDTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		TokenType    string `json:"token_type"`
+		ExpiresIn    int64  `json:"expires_in"`
+		Error        string `json:"error"`
+		Description  string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("harvest: decoding token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if body.Error == "invalid_grant" || resp.StatusCode == http.StatusUnauthorized {
+			return nil, fmt.Errorf("%w: %s %s", ErrReauthRequired, body.Error, body.Description)
+		}
+		return nil, fmt.Errorf("harvest: token request failed: %d %s %s", resp.StatusCode, body.Error, body.Description)
+	}
+
+	token := &Token{
+		AccessToken:  body.AccessToken,
+		RefreshToken: body.RefreshToken,
+		TokenType:    body.TokenType,
+	}
+	if body.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
@@ -0,0 +1,146 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// expiringToken returns a token expiring in d.
+func expiringToken(d time.Duration) *Token {
+	return &Token{AccessToken: "old", RefreshToken: "refresh", Expiry: time.Now().Add(d)}
+}
+
+func TestRefreshingTokenSourceSharesRefresh(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	ts := NewRefreshingTokenSource(expiringToken(time.Second), func(ctx context.Context, refreshToken string) (*Token, error) {
+		calls.Add(1)
+		<-release
+		return &Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+	}, 0)
+
+	const n = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for range n {
+		wg.Go(func() {
+			token, err := ts.Token(context.Background())
+			if err == nil && token.AccessToken != "new" {
+				err = fmt.Errorf("access token = %q, want %q", token.AccessToken, "new")
+			}
+			errs <- err
+		})
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("refresh calls = %d, want 1", got)
+	}
+}
+
+func TestRefreshingTokenSourceCancelledWaiter(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var refreshErr error
+	ts := NewRefreshingTokenSource(expiringToken(0), func(ctx context.Context, refreshToken string) (*Token, error) {
+		close(started)
+		<-release
+		refreshErr = ctx.Err()
+		return &Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+	}, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := ts.Token(ctx)
+		first <- err
+	}()
+	<-started
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled caller error = %v, want context.Canceled", err)
+	}
+
+	close(release)
+	token, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token after cancelled waiter: %v", err)
+	}
+	if token.AccessToken != "new" {
+		t.Errorf("access token = %q, want %q", token.AccessToken, "new")
+	}
+	if refreshErr != nil {
+		t.Errorf("refresh context error = %v, want nil", refreshErr)
+	}
+}
+
+func TestRefreshingTokenSourceReauthRequired(t *testing.T) {
+	var calls atomic.Int32
+	ts := NewRefreshingTokenSource(expiringToken(0), func(ctx context.Context, refreshToken string) (*Token, error) {
+		calls.Add(1)
+		return nil, fmt.Errorf("%w: invalid_grant", ErrReauthRequired)
+	}, 0)
+
+	for i := range 3 {
+		if _, err := ts.Token(context.Background()); !errors.Is(err, ErrReauthRequired) {
+			t.Fatalf("call %d error = %v, want ErrReauthRequired", i, err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("refresh calls = %d, want 1", got)
+	}
+}
+
+func TestRefreshingTokenSourceClockSkew(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiresIn   time.Duration
+		skew        time.Duration
+		wantRefresh bool
+	}{
+		{"within default skew", DefaultClockSkew / 2, 0, true},
+		{"outside default skew", 2 * DefaultClockSkew, 0, false},
+		{"within custom skew", 4 * time.Minute, 5 * time.Minute, true},
+		{"outside custom skew", 6 * time.Minute, 5 * time.Minute, false},
+		{"already expired", -time.Minute, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refreshed := false
+			ts := NewRefreshingTokenSource(expiringToken(tt.expiresIn), func(ctx context.Context, refreshToken string) (*Token, error) {
+				refreshed = true
+				return &Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+			}, tt.skew)
+
+			if _, err := ts.Token(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if refreshed != tt.wantRefresh {
+				t.Errorf("refreshed = %v, want %v", refreshed, tt.wantRefresh)
+			}
+		})
+	}
+}
+
+func TestRefreshingTokenSourceNilToken(t *testing.T) {
+	ts := NewRefreshingTokenSource(expiringToken(0), func(ctx context.Context, refreshToken string) (*Token, error) {
+		return nil, nil
+	}, 0)
+
+	if _, err := ts.Token(context.Background()); err == nil {
+		t.Fatal("Token returned no error for a refresher returning no token")
+	}
+}
@@ -0,0 +1,133 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillingRunOptions configures InvoicesService.CreateFromUninvoiced.
+type BillingRunOptions struct {
+	// From and To bound the period to invoice, in YYYY-MM-DD format.
+	From string
+	To   string
+	// MinAmount skips clients whose uninvoiced amount is below it.
+	MinAmount decimal.Decimal
+	// TimeSummary is how time is grouped into line items: "project",
+	// "task", "people" or "detailed". It defaults to "task".
+	TimeSummary string
+	// ExpenseSummary is how expenses are grouped into line items:
+	// "project", "category", "people" or "detailed". It defaults to
+	// "category".
+	ExpenseSummary string
+	// Subject is the invoice subject. It defaults to "Services <From> to <To>".
+	Subject     string
+	IssueDate   string
+	PaymentTerm string
+	// PaymentOptions are the online payment methods offered on the
+	// invoices.
+	PaymentOptions []PaymentOption
+}
+
+// SkippedClient is a client left out of a billing run.
+type SkippedClient struct {
+	ClientID   ClientID
+	ClientName string
+	Amount     decimal.Decimal
+	Currency   string
+}
+
+// BillingRun is the outcome of InvoicesService.CreateFromUninvoiced.
+type BillingRun struct {
+	Created []Invoice
+	Skipped []SkippedClient
+}
+
+// CreateFromUninvoiced runs the uninvoiced report for a period and creates
+// a draft invoice for each client whose uninvoiced amount is at least
+// opts.MinAmount. Harvest imports the line items from the client's projects
+// using line_items_import. If a create fails, the run stops and the
+// invoices created so far are returned with the error. opts is required;
+// a nil opts fails validation like one without From and To.
+//
+// Experimental: this API may change in minor releases.
+func (s *InvoicesService) CreateFromUninvoiced(ctx context.Context, opts *BillingRunOptions) (*BillingRun, error) {
+	if opts == nil {
+		opts = &BillingRunOptions{}
+	}
+	var errs fieldErrors
+	errs.require(opts.From != "", "from", "is required")
+	errs.require(opts.To != "", "to", "is required")
+	errs.date(opts.From, "from")
+	errs.date(opts.To, "to")
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	timeSummary, expenseSummary, subject := opts.TimeSummary, opts.ExpenseSummary, opts.Subject
+	if timeSummary == "" {
+		timeSummary = "task"
+	}
+	if expenseSummary == "" {
+		expenseSummary = "category"
+	}
+	if subject == "" {
+		subject = fmt.Sprintf("Services %s to %s", opts.From, opts.To)
+	}
+
+	rows, err := collect(s.client.Reports.AllUninvoicedReports(ctx, &UninvoicedReportOptions{From: opts.From, To: opts.To}))
+	if err != nil {
+		return nil, err
+	}
+
+	// Group project rows by client, keeping the report's order.
+	var order []ClientID
+	byClient := make(map[ClientID][]UninvoicedReport)
+	for _, row := range rows {
+		if _, ok := byClient[row.ClientID]; !ok {
+			order = append(order, row.ClientID)
+		}
+		byClient[row.ClientID] = append(byClient[row.ClientID], row)
+	}
+
+	run := &BillingRun{}
+	for _, clientID := range order {
+		projects := byClient[clientID]
+		amount := decimal.Zero
+		var projectIDs []ProjectID
+		for _, p := range projects {
+			amount = amount.Add(p.UninvoicedAmount)
+			if p.UninvoicedAmount.IsPositive() {
+				projectIDs = append(projectIDs, p.ProjectID)
+			}
+		}
+		if len(projectIDs) == 0 || amount.LessThan(opts.MinAmount) {
+			run.Skipped = append(run.Skipped, SkippedClient{
+				ClientID:   clientID,
+				ClientName: projects[0].ClientName,
+				Amount:     amount,
+				Currency:   projects[0].Currency,
+			})
+			continue
+		}
+
+		invoice, err := s.Create(ctx, &InvoiceCreateRequest{
+			ClientID:       clientID,
+			Currency:       projects[0].Currency,
+			Subject:        subject,
+			IssueDate:      opts.IssueDate,
+			PaymentTerm:    opts.PaymentTerm,
+			PaymentOptions: opts.PaymentOptions,
+			LineItemsImport: &InvoiceLineItemsImport{
+				ProjectIDs: projectIDs,
+				Time:       &InvoiceTimeImport{SummaryType: timeSummary, From: opts.From, To: opts.To},
+				Expenses:   &InvoiceExpensesImport{SummaryType: expenseSummary, From: opts.From, To: opts.To},
+			},
+		})
+		if err != nil {
+			return run, fmt.Errorf("harvest: invoicing client %d: %w", clientID, err)
+		}
+		run.Created = append(run.Created, *invoice)
+	}
+	return run, nil
+}
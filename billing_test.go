@@ -0,0 +1,18 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestCreateFromUninvoicedNilOptions(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	_, err := c.Invoices.CreateFromUninvoiced(context.Background(), nil)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+}
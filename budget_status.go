@@ -0,0 +1,92 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoBudget is returned by BudgetStatus for projects without a budget.
+var ErrNoBudget = errors.New("harvest: project has no budget")
+
+// ProjectBudgetStatus is how much of a project's budget has been spent.
+// Amounts are hours for budgets by project, task or person and money in
+// the client's currency for budgets by fees.
+type ProjectBudgetStatus struct {
+	ProjectID ProjectID
+	BudgetBy  BudgetBy
+	// Monthly is true when the budget resets each month; Spent then covers
+	// the current month only.
+	Monthly   bool
+	Budget    decimal.Decimal
+	Spent     decimal.Decimal
+	Remaining decimal.Decimal
+	// PercentSpent is Spent as a percentage of Budget, or nil when Budget
+	// is zero.
+	PercentSpent *decimal.Decimal
+}
+
+// BudgetStatus returns how much of a project's budget has been spent. It
+// uses the project's row in the project budget report when there is one,
+// and otherwise totals the project's time entries: hours for budgets by
+// hours, and rounded billable hours times their billable rates for budgets
+// by fees. It returns an error wrapping ErrNoBudget when the project has no
+// budget.
+//
+// Experimental: this API may change in minor releases.
+func (s *ProjectsService) BudgetStatus(ctx context.Context, projectID ProjectID) (*ProjectBudgetStatus, error) {
+	for row, err := range s.client.Reports.AllProjectBudgetReports(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if row.ProjectID != projectID || row.Budget == nil {
+			continue
+		}
+		return newBudgetStatus(projectID, row.BudgetBy, row.BudgetIsMonthly, *row.Budget, row.BudgetSpent), nil
+	}
+
+	project, err := s.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Budget == nil || project.BudgetBy == BudgetByNone || project.BudgetBy == "" {
+		return nil, fmt.Errorf("%w: project %d", ErrNoBudget, projectID)
+	}
+
+	opts := &TimeEntryListOptions{ProjectID: projectID}
+	if project.BudgetIsMonthly {
+		now := time.Now()
+		opts.From = NewDate(now.Year(), now.Month(), 1).String()
+	}
+	entries, err := s.client.TimeEntries.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var spent decimal.Decimal
+	for _, e := range entries {
+		if project.BudgetBy == BudgetByProjectCost || project.BudgetBy == BudgetByTaskFees {
+			if e.Billable && e.BillableRate != nil {
+				spent = spent.Add(e.RoundedHours.Mul(*e.BillableRate))
+			}
+			continue
+		}
+		spent = spent.Add(e.Hours)
+	}
+	return newBudgetStatus(projectID, project.BudgetBy, project.BudgetIsMonthly, *project.Budget, spent), nil
+}
+
+func newBudgetStatus(projectID ProjectID, by BudgetBy, monthly bool, budget, spent decimal.Decimal) *ProjectBudgetStatus {
+	return &ProjectBudgetStatus{
+		ProjectID:    projectID,
+		BudgetBy:     by,
+		Monthly:      monthly,
+		Budget:       budget,
+		Spent:        spent,
+		Remaining:    budget.Sub(spent),
+		PercentSpent: percentOf(spent, budget),
+	}
+}
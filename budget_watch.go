@@ -0,0 +1,162 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BudgetAlert reports a project whose spent budget has reached a threshold.
+type BudgetAlert struct {
+	Project ProjectBudgetReport
+	// Threshold is the highest threshold reached, as a percentage.
+	Threshold decimal.Decimal
+	// PercentSpent is BudgetSpent as a percentage of Budget.
+	PercentSpent decimal.Decimal
+}
+
+// BudgetWatch checks project budgets against percentage thresholds and calls
+// a function for each project that crosses one. It remembers which
+// thresholds it has reported, so each is reported once per project, or once
+// per calendar month for monthly budgets. Call Check on a schedule, or use
+// Run.
+//
+// Experimental: this API may change in minor releases.
+type BudgetWatch struct {
+	client     *API
+	onAlert    func(context.Context, BudgetAlert)
+	thresholds []decimal.Decimal
+	monthly    []decimal.Decimal
+	opts       ProjectBudgetReportOptions
+
+	mu      sync.Mutex
+	alerted map[ProjectID]budgetMark
+}
+
+// budgetMark is the highest threshold reported for a project in a period.
+type budgetMark struct {
+	period    string
+	threshold decimal.Decimal
+}
+
+// NewBudgetWatch returns a BudgetWatch that calls onAlert when an active
+// project's spent budget reaches any of thresholds, given as percentages
+// such as 80 and 100. onAlert may be nil when only the alerts returned by
+// Check are used.
+func NewBudgetWatch(client *API, onAlert func(context.Context, BudgetAlert), thresholds ...decimal.Decimal) *BudgetWatch {
+	return &BudgetWatch{
+		client:     client,
+		onAlert:    onAlert,
+		thresholds: sortedThresholds(thresholds),
+		opts:       ProjectBudgetReportOptions{IsActive: Bool(true)},
+		alerted:    make(map[ProjectID]budgetMark),
+	}
+}
+
+// Monthly sets separate thresholds for projects with monthly budgets. By
+// default they use the same thresholds as other projects.
+func (w *BudgetWatch) Monthly(thresholds ...decimal.Decimal) *BudgetWatch {
+	w.monthly = sortedThresholds(thresholds)
+	return w
+}
+
+// Filter sets the options used to fetch the project budget report, for
+// example to watch a single client. Only active projects are watched by
+// default.
+func (w *BudgetWatch) Filter(opts ProjectBudgetReportOptions) *BudgetWatch {
+	w.opts = opts
+	return w
+}
+
+// Check fetches the project budget report, calls onAlert for each project
+// that has reached a threshold not yet reported, and returns those alerts.
+func (w *BudgetWatch) Check(ctx context.Context) ([]BudgetAlert, error) {
+	opts := w.opts
+	rows, err := collect(w.client.Reports.AllProjectBudgetReports(ctx, &opts))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var alerts []BudgetAlert
+	w.mu.Lock()
+	for _, row := range rows {
+		if alert, ok := w.evaluate(row, now); ok {
+			alerts = append(alerts, alert)
+		}
+	}
+	w.mu.Unlock()
+
+	if w.onAlert != nil {
+		for _, alert := range alerts {
+			w.onAlert(ctx, alert)
+		}
+	}
+	return alerts, nil
+}
+
+// Run calls Check immediately and then every interval until ctx is done or
+// a check fails. interval must be positive.
+func (w *BudgetWatch) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("harvest: budget watch interval %v must be positive", interval)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Check(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluate returns an alert if row has reached a threshold higher than the
+// last one reported for its project in the current period.
+func (w *BudgetWatch) evaluate(row ProjectBudgetReport, now time.Time) (BudgetAlert, bool) {
+	budget := valueOf(row.Budget)
+	if !budget.IsPositive() {
+		return BudgetAlert{}, false
+	}
+	thresholds, period := w.thresholds, ""
+	if row.BudgetIsMonthly {
+		period = now.Format("2006-01")
+		if w.monthly != nil {
+			thresholds = w.monthly
+		}
+	}
+
+	percent := row.BudgetSpent.Div(budget).Mul(decimal.NewFromInt(100))
+	var reached decimal.Decimal
+	var ok bool
+	for _, t := range thresholds {
+		if percent.GreaterThanOrEqual(t) {
+			reached, ok = t, true
+		}
+	}
+	if !ok {
+		return BudgetAlert{}, false
+	}
+
+	mark, seen := w.alerted[row.ProjectID]
+	if seen && mark.period == period && !reached.GreaterThan(mark.threshold) {
+		return BudgetAlert{}, false
+	}
+	w.alerted[row.ProjectID] = budgetMark{period: period, threshold: reached}
+	return BudgetAlert{Project: row, Threshold: reached, PercentSpent: percent.Round(2)}, true
+}
+
+// sortedThresholds returns a sorted copy of thresholds.
+func sortedThresholds(thresholds []decimal.Decimal) []decimal.Decimal {
+	sorted := slices.Clone(thresholds)
+	slices.SortFunc(sorted, func(a, b decimal.Decimal) int { return a.Cmp(b) })
+	return sorted
+}
@@ -0,0 +1,44 @@
+package harvest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestBudgetWatchNilOnAlert(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"results": [{"project_id": 14307913, "project_name": "Marketing Website", "budget": 100.0, "budget_spent": 85.0}], "page": 1, "total_pages": 1, "total_entries": 1}`)
+	})
+	w := NewBudgetWatch(newTestClient(t, handler), nil, decimal.NewFromInt(80))
+
+	alerts, err := w.Check(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || !alerts[0].Threshold.Equal(decimal.NewFromInt(80)) {
+		t.Errorf("alerts = %+v, want one at 80%%", alerts)
+	}
+}
+
+func TestBudgetWatchRunRejectsInterval(t *testing.T) {
+	var requests atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	})
+	w := NewBudgetWatch(newTestClient(t, handler), nil, decimal.NewFromInt(80))
+
+	for _, interval := range []time.Duration{0, -time.Minute} {
+		if err := w.Run(context.Background(), interval); err == nil {
+			t.Errorf("Run(%v) returned nil, want an error", interval)
+		}
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("made %d requests, want none", n)
+	}
+}
@@ -0,0 +1,158 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// BulkOptions controls how bulk operations spread requests over time.
+type BulkOptions struct {
+	// Concurrency is the number of requests in flight at once. It defaults
+	// to 4.
+	Concurrency int
+	// MaxRetries is how often a request is retried after a rate limit or
+	// temporary server error; creates are not retried after a 502 or 504.
+	// It defaults to 3; use a negative value to disable retries.
+	MaxRetries int
+}
+
+// BulkResult is the outcome of one item of a bulk operation. Exactly one of
+// Item and Err is set.
+type BulkResult[T any] struct {
+	// Index is the position of the input the result belongs to.
+	Index int
+	Item  *T
+	Err   error
+}
+
+// BulkCreate creates entries with bounded concurrency and returns one
+// result per entry, in input order. A failed entry does not stop the
+// others. Requests pause when the last reported rate limit has no room for
+// the requests in flight, and are retried after a 429 or 503 response,
+// when Harvest has not created the entry. A 502 or 504 is not retried,
+// since the entry may have been created behind the gateway. Other errors,
+// including validation and notes policy errors, are reported without
+// retrying.
+//
+// Experimental: this API may change in minor releases.
+func (s *TimeEntriesService) BulkCreate(ctx context.Context, entries []TimeEntryCreateViaDurationRequest, opts *BulkOptions) []BulkResult[TimeEntry] {
+	return bulk(ctx, s.client, entries, opts, func(ctx context.Context, entry *TimeEntryCreateViaDurationRequest) (*TimeEntry, error) {
+		return s.CreateViaDuration(ctx, entry)
+	})
+}
+
+// bulk calls do for each input using opts.Concurrency workers and collects
+// the results in input order.
+func bulk[R, T any](ctx context.Context, c *API, inputs []R, opts *BulkOptions, do func(context.Context, *R) (*T, error)) []BulkResult[T] {
+	var o BulkOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 3
+	}
+
+	results := make([]BulkResult[T], len(inputs))
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+		for i := range inputs {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range min(o.Concurrency, len(inputs)) {
+		wg.Go(func() {
+			for i := range indexes {
+				item, err := bulkCall(ctx, c, o, func(ctx context.Context) (*T, error) {
+					return do(ctx, &inputs[i])
+				})
+				results[i] = BulkResult[T]{Index: i, Item: item, Err: err}
+			}
+		})
+	}
+	wg.Wait()
+
+	// Inputs never handed to a worker were skipped because ctx is done.
+	for i := range results {
+		if results[i].Item == nil && results[i].Err == nil {
+			results[i] = BulkResult[T]{Index: i, Err: ctx.Err()}
+		}
+	}
+	return results
+}
+
+// bulkCall waits for rate limit headroom and calls do, retrying rate
+// limited and temporarily unavailable requests. Creates are only retried
+// after a 429 or 503; see temporaryStatus.
+func bulkCall[T any](ctx context.Context, c *API, o BulkOptions, do func(context.Context) (*T, error)) (*T, error) {
+	for attempt := 0; ; attempt++ {
+		if rate := c.usage.lastRate(); rate.Limit > 0 && rate.Remaining < o.Concurrency {
+			if err := sleepCtx(ctx, time.Until(rate.Reset.Time)); err != nil {
+				return nil, err
+			}
+		}
+
+		item, err := do(ctx)
+		if err == nil || attempt >= o.MaxRetries {
+			return item, err
+		}
+
+		var wait time.Duration
+		var rateErr *RateLimitError
+		var errResp *ErrorResponse
+		switch {
+		case errors.As(err, &rateErr):
+			wait = retryAfter(rateErr)
+		case errors.As(err, &errResp) && temporaryStatus(errResp.Response):
+			wait = time.Second << attempt
+		default:
+			return nil, err
+		}
+		if err := sleepCtx(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// temporaryStatus reports whether resp means the request may succeed if
+// sent again without risk of applying it twice. A 503 means Harvest did not
+// process the request. A 502 or 504 comes from a gateway and says nothing
+// about whether Harvest did, so only requests that are safe to repeat are
+// retried after one; creates (POST) are not.
+func temporaryStatus(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return resp.Request != nil && resp.Request.Method != http.MethodPost
+	}
+	return false
+}
+
+// sleepCtx waits for d or until ctx is done, returning ctx's error in the
+// latter case.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
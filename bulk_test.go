@@ -0,0 +1,49 @@
+package harvest
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBulkCallGatewayErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		calls  int32
+	}{
+		{"create after 502", http.MethodPost, http.StatusBadGateway, 1},
+		{"create after 504", http.MethodPost, http.StatusGatewayTimeout, 1},
+		{"create after 503", http.MethodPost, http.StatusServiceUnavailable, 2},
+		{"get after 502", http.MethodGet, http.StatusBadGateway, 2},
+		{"delete after 504", http.MethodDelete, http.StatusGatewayTimeout, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) == 1 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Write([]byte(`{"id":1}`))
+			}))
+
+			o := BulkOptions{Concurrency: 1, MaxRetries: 1}
+			_, _ = bulkCall(context.Background(), c, o, func(ctx context.Context) (*TimeEntry, error) {
+				switch tt.method {
+				case http.MethodPost:
+					return Create[TimeEntry](ctx, c, "time_entries", map[string]any{})
+				case http.MethodDelete:
+					return nil, Delete(ctx, c, "time_entries/1")
+				}
+				return Get[TimeEntry](ctx, c, "time_entries/1")
+			})
+			if got := calls.Load(); got != tt.calls {
+				t.Errorf("requests = %d, want %d", got, tt.calls)
+			}
+		})
+	}
+}
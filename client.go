@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -27,6 +30,18 @@ type API struct {
 	accessToken string
 	accountID   string
 	userAgent   string
+	uaSuffixes  []string
+	debug       io.Writer
+	notesPolicy NotesPolicy
+	tokenSource TokenSource
+	timeout     time.Duration
+	pageTimeout time.Duration
+	pageRetries int
+	prefetch    int
+	extraFields bool
+	logger      *slog.Logger
+	deprecated  sync.Map
+	usage       *usage
 
 	// Service endpoints
 	Company     *CompanyService
@@ -45,7 +60,7 @@ type API struct {
 
 // New creates a new Harvest API client with the given User-Agent.
 // It reads HARVEST_ACCESS_TOKEN and HARVEST_ACCOUNT_ID from environment variables.
-func New(userAgent string) (*API, error) {
+func New(userAgent string, opts ...Option) (*API, error) {
 	accessToken := os.Getenv("HARVEST_ACCESS_TOKEN")
 	if accessToken == "" {
 		return nil, fmt.Errorf("HARVEST_ACCESS_TOKEN environment variable is required")
@@ -60,19 +75,16 @@ func New(userAgent string) (*API, error) {
 		return nil, fmt.Errorf("User-Agent is required (format: 'AppName (contact@example.com)')")
 	}
 
-	return NewWithConfig(accessToken, accountID, userAgent, nil)
+	return NewWithConfig(accessToken, accountID, userAgent, nil, opts...)
 }
 
 // NewWithConfig creates a new Harvest API client with custom configuration.
-func NewWithConfig(accessToken, accountID, userAgent string, httpClient *http.Client) (*API, error) {
-	if accessToken == "" || accountID == "" || userAgent == "" {
-		return nil, fmt.Errorf("accessToken, accountID, and userAgent are required")
-	}
-
+// The accessToken may be empty when a TokenSource is supplied with WithTokenSource.
+func NewWithConfig(accessToken, accountID, userAgent string, httpClient *http.Client, opts ...Option) (*API, error) {
+	// The default timeout is applied per call in Do rather than on the
+	// http.Client so that WithTimeout can extend it for slow endpoints.
 	if httpClient == nil {
-		httpClient = &http.Client{
-			Timeout: defaultTimeout,
-		}
+		httpClient = &http.Client{}
 	}
 
 	baseURL, err := url.Parse(defaultBaseURL)
@@ -86,6 +98,19 @@ func NewWithConfig(accessToken, accountID, userAgent string, httpClient *http.Cl
 		accessToken: accessToken,
 		accountID:   accountID,
 		userAgent:   userAgent,
+		timeout:     defaultTimeout,
+		usage:       newUsage(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+	if len(c.uaSuffixes) > 0 {
+		c.userAgent = strings.TrimSpace(c.userAgent + " " + strings.Join(c.uaSuffixes, " "))
+	}
+
+	if (c.accessToken == "" && c.tokenSource == nil) || accountID == "" || c.userAgent == "" {
+		return nil, fmt.Errorf("accessToken, accountID, and userAgent are required")
 	}
 
 	// Initialize services
@@ -131,8 +156,17 @@ func (c *API) NewRequest(ctx context.Context, method, urlStr string, body any) (
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	accessToken := c.accessToken
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accessToken = token.AccessToken
+	}
+
 	// Set required headers
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	req.Header.Set("Authorization", "Bearer "+accessToken)
 	req.Header.Set("Harvest-Account-Id", c.accountID)
 	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("Accept", "application/json")
@@ -141,7 +175,38 @@ func (c *API) NewRequest(ctx context.Context, method, urlStr string, body any) (
 }
 
 // Do sends an API request and returns the API response.
+// The call is bounded by the client's default timeout unless ctx carries a
+// per-call timeout set with WithTimeout.
 func (c *API) Do(ctx context.Context, req *http.Request, v any) (*http.Response, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	if c.debug != nil {
+		c.dumpRequest(req)
+	}
+
+	start := time.Now()
+	resp, err := c.do(ctx, req, v)
+	c.usage.record(c.endpointName(req), resp, err, time.Since(start))
+	return resp, err
+}
+
+// withTimeout bounds ctx by the per-call timeout set with WithTimeout, or
+// else the client's default timeout.
+func (c *API) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := c.timeout
+	if d, ok := ctx.Value(timeoutKey{}).(time.Duration); ok {
+		timeout = d
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// do performs the request for Do.
+func (c *API) do(ctx context.Context, req *http.Request, v any) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		select {
@@ -153,12 +218,19 @@ func (c *API) Do(ctx context.Context, req *http.Request, v any) (*http.Response,
 	}
 	defer resp.Body.Close()
 
+	if c.debug != nil {
+		c.dumpResponse(resp)
+	}
+
 	// Check for API errors
 	if err := CheckResponse(resp); err != nil {
 		return resp, err
 	}
 
 	if v != nil && resp.StatusCode != http.StatusNoContent {
+		if c.extraFields {
+			return resp, decodeExtra(resp.Body, v)
+		}
 		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
 			return resp, err
 		}
@@ -171,6 +243,15 @@ func (c *API) Do(ctx context.Context, req *http.Request, v any) (*http.Response,
 
 // ListPage performs a GET request to list resources with pagination, returning a single page.
 func ListPage[T any](ctx context.Context, c *API, path string, opts *ListOptions) (*Paginated[T], error) {
+	return listPage[T, Paginated[T]](ctx, c, path, opts)
+}
+
+// listPage performs a GET request for a single page of a list endpoint and
+// decodes it into a new L, syncing its named item field from Items.
+func listPage[T any, L any, PL interface {
+	*L
+	page() *Paginated[T]
+}](ctx context.Context, c *API, path string, opts any) (PL, error) {
 	u, err := addOptions(path, opts)
 	if err != nil {
 		return nil, err
@@ -181,18 +262,25 @@ func ListPage[T any](ctx context.Context, c *API, path string, opts *ListOptions
 		return nil, err
 	}
 
-	var result Paginated[T]
-	_, err = c.Do(ctx, req, &result)
+	result := PL(new(L))
+	_, err = c.Do(ctx, req, result)
 	if err != nil {
 		return nil, err
 	}
+	result.page()
 
-	return &result, nil
+	return result, nil
 }
 
 // ListPageFromURL performs a GET request using a full pagination URL.
 // This is used for cursor-based pagination where the API provides full URLs in the links section.
 func ListPageFromURL[T any](ctx context.Context, c *API, fullURL string) (*Paginated[T], error) {
+	return getFromURL[Paginated[T]](ctx, c, fullURL)
+}
+
+// getFromURL performs a GET request using a full URL returned by the API and
+// decodes the response into a new L.
+func getFromURL[L any](ctx context.Context, c *API, fullURL string) (*L, error) {
 	// Parse the full URL to extract just the path and query
 	u, err := url.Parse(fullURL)
 	if err != nil {
@@ -210,7 +298,7 @@ func ListPageFromURL[T any](ctx context.Context, c *API, fullURL string) (*Pagin
 		return nil, err
 	}
 
-	var result Paginated[T]
+	var result L
 	_, err = c.Do(ctx, req, &result)
 	if err != nil {
 		return nil, err
@@ -232,39 +320,11 @@ func List[T any](ctx context.Context, c *API, path string, opts *ListOptions) ([
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allItems []T
-
-	// Fetch first page
-	result, err := ListPage[T](ctx, c, path, opts)
-	if err != nil {
-		return nil, err
-	}
-	allItems = append(allItems, result.Items...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Use cursor-based pagination (follow the Links.Next URL)
-			result, err = ListPageFromURL[T](ctx, c, nextURL)
-			if err != nil {
-				return nil, err
-			}
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = ListPage[T](ctx, c, path, opts)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			// Should not reach here if HasNextPage is working correctly
-			break
-		}
-		allItems = append(allItems, result.Items...)
-	}
-
-	return allItems, nil
+	return listAll[T](ctx, c, *opts, func(ctx context.Context, page int) (*Paginated[T], error) {
+		o := *opts
+		o.Page = page
+		return ListPage[T](ctx, c, path, &o)
+	})
 }
 
 // Get performs a GET request to retrieve a single resource.
@@ -284,7 +344,12 @@ func Get[T any](ctx context.Context, c *API, path string) (*T, error) {
 }
 
 // Create performs a POST request to create a new resource.
+// Bodies with a Validate method are validated before the request is sent.
 func Create[T any](ctx context.Context, c *API, path string, body any) (*T, error) {
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
 	req, err := c.NewRequest(ctx, "POST", path, body)
 	if err != nil {
 		return nil, err
@@ -300,7 +365,12 @@ func Create[T any](ctx context.Context, c *API, path string, body any) (*T, erro
 }
 
 // Update performs a PATCH request to update an existing resource.
+// Bodies with a Validate method are validated before the request is sent.
 func Update[T any](ctx context.Context, c *API, path string, body any) (*T, error) {
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
 	req, err := c.NewRequest(ctx, "PATCH", path, body)
 	if err != nil {
 		return nil, err
@@ -326,6 +396,18 @@ func Delete(ctx context.Context, c *API, path string) error {
 	return err
 }
 
+// warnDeprecated logs, once per client, that the deprecated method name was
+// called and what should be used instead.
+func (c *API) warnDeprecated(name, replacement string) {
+	if c.logger == nil {
+		return
+	}
+	if _, seen := c.deprecated.LoadOrStore(name, struct{}{}); seen {
+		return
+	}
+	c.logger.Warn("harvest: deprecated method called", "method", name, "replacement", replacement)
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s.
 func addOptions(s string, opts any) (string, error) {
 	v, err := query.Values(opts)
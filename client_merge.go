@@ -0,0 +1,85 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+)
+
+// ClientMergeReport lists what Merge moved, or would move in a dry run.
+type ClientMergeReport struct {
+	Source   *Client
+	Target   *Client
+	Contacts []Contact
+	Projects []Project
+	// Archived is true when the source client was archived.
+	Archived bool
+	DryRun   bool
+}
+
+// Merge moves the contacts and projects of the source client to the target
+// client and then archives the source, for cleaning up duplicate clients.
+// Invoices and estimates stay with the source client. With dryRun, nothing
+// is changed and the report lists what would be moved. Requests pause and
+// retry on rate limits like BulkCreate. If a move fails, the report of what
+// was moved so far is returned with the error, and the source client is
+// left active.
+//
+// Experimental: this API may change in minor releases.
+func (s *ClientsService) Merge(ctx context.Context, sourceID, targetID ClientID, dryRun bool) (*ClientMergeReport, error) {
+	var errs fieldErrors
+	errs.require(sourceID != targetID, "target_id", "must differ from the source client")
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+
+	source, err := s.Get(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.Get(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	contacts, err := s.client.Contacts.ListForClient(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.client.Projects.List(ctx, &ProjectListOptions{ClientID: sourceID})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ClientMergeReport{Source: source, Target: target, DryRun: dryRun}
+	if dryRun {
+		report.Contacts, report.Projects = contacts, projects
+		return report, nil
+	}
+
+	o := BulkOptions{Concurrency: 1, MaxRetries: 3}
+	for _, c := range contacts {
+		moved, err := bulkCall(ctx, s.client, o, func(ctx context.Context) (*Contact, error) {
+			return s.client.Contacts.Update(ctx, c.ID, &ContactUpdateRequest{ClientID: targetID})
+		})
+		if err != nil {
+			return report, fmt.Errorf("harvest: moving contact %d: %w", c.ID, err)
+		}
+		report.Contacts = append(report.Contacts, *moved)
+	}
+	for _, p := range projects {
+		moved, err := bulkCall(ctx, s.client, o, func(ctx context.Context) (*Project, error) {
+			return s.client.Projects.Update(ctx, p.ID, &ProjectUpdateRequest{ClientID: targetID})
+		})
+		if err != nil {
+			return report, fmt.Errorf("harvest: moving project %q: %w", p.Name, err)
+		}
+		report.Projects = append(report.Projects, *moved)
+	}
+
+	if source.IsActive {
+		if _, err := bulkCall(ctx, s.client, o, func(ctx context.Context) (*Client, error) { return s.Archive(ctx, sourceID) }); err != nil {
+			return report, fmt.Errorf("harvest: archiving client %d: %w", sourceID, err)
+		}
+		report.Archived = true
+	}
+	return report, nil
+}
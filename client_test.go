@@ -0,0 +1,24 @@
+package harvest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestClient returns a client configured with opts whose requests are
+// served by handler.
+func newTestClient(t *testing.T, handler http.Handler, opts ...Option) *API {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c, err := NewWithConfig("token", "123", "harvest-test", srv.Client(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.baseURL, err = c.baseURL.Parse(srv.URL + "/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
@@ -3,6 +3,8 @@ package harvest
 import (
 	"context"
 	"fmt"
+	"iter"
+	"strings"
 )
 
 // ClientsService handles communication with the client related
@@ -14,8 +16,7 @@ type ClientsService struct {
 // ClientListOptions specifies optional parameters to the List method.
 type ClientListOptions struct {
 	ListOptions
-	IsActive     *bool  `url:"is_active,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	IsActive *bool `url:"is_active,omitempty"`
 }
 
 // ClientList represents a list of clients.
@@ -24,32 +25,40 @@ type ClientList struct {
 	Paginated[Client]
 }
 
+func (l *ClientList) page() *Paginated[Client] {
+	l.Clients = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Clients and Items.
+func (l *ClientList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "clients", &l.Paginated, &l.Clients)
+}
+
 // ListPage returns a single page of clients.
 func (s *ClientsService) ListPage(ctx context.Context, opts *ClientListOptions) (*ClientList, error) {
-	u, err := addOptions("clients", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Client, ClientList](ctx, s.client, "clients", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all clients across all pages.
+func (s *ClientsService) List(ctx context.Context, opts *ClientListOptions) ([]Client, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var clients ClientList
-	_, err = s.client.Do(ctx, req, &clients)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *ClientsService) ListWithTotals(ctx context.Context, opts *ClientListOptions) (*ListResult[Client], error) {
+	if opts == nil {
+		opts = &ClientListOptions{}
 	}
-
-	// Copy clients to Items for pagination
-	clients.Items = clients.Clients
-
-	return &clients, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Client, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all clients across all pages.
-func (s *ClientsService) List(ctx context.Context, opts *ClientListOptions) ([]Client, error) {
+// All returns an iterator over all clients, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *ClientsService) All(ctx context.Context, opts *ClientListOptions) iter.Seq2[Client, error] {
 	if opts == nil {
 		opts = &ClientListOptions{}
 	}
@@ -60,28 +69,37 @@ func (s *ClientsService) List(ctx context.Context, opts *ClientListOptions) ([]C
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allClients []Client
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allClients = append(allClients, result.Clients...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Client](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*ClientList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all clients on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ClientsService) Stream(ctx context.Context, opts *ClientListOptions) (<-chan Client, <-chan error) {
+	if opts == nil {
+		opts = &ClientListOptions{}
 	}
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
 
-	return allClients, nil
+// StatementURL returns the link to the client's statement of invoices and
+// payments, built from the account's base URI and the client's statement
+// key. It returns "" when the client has no statement key.
+func (c *Client) StatementURL(company *Company) string {
+	if c.StatementKey == "" {
+		return ""
+	}
+	return strings.TrimSuffix(company.BaseURI, "/") + "/client/statements/" + c.StatementKey
 }
 
 // Get retrieves a specific client.
-func (s *ClientsService) Get(ctx context.Context, clientID int64) (*Client, error) {
+func (s *ClientsService) Get(ctx context.Context, clientID ClientID) (*Client, error) {
 	return Get[Client](ctx, s.client, fmt.Sprintf("clients/%d", clientID))
 }
 
@@ -93,6 +111,14 @@ type ClientCreateRequest struct {
 	Currency string `json:"currency,omitempty"`
 }
 
+// Validate checks that the required fields are set.
+func (r *ClientCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Name != "", "name", "is required")
+	errs.currency(r.Currency, "currency")
+	return errs.err()
+}
+
 // Create creates a new client.
 func (s *ClientsService) Create(ctx context.Context, client *ClientCreateRequest) (*Client, error) {
 	return Create[Client](ctx, s.client, "clients", client)
@@ -100,19 +126,29 @@ func (s *ClientsService) Create(ctx context.Context, client *ClientCreateRequest
 
 // ClientUpdateRequest represents a request to update a client.
 type ClientUpdateRequest struct {
-	Name     string `json:"name,omitempty"`
-	IsActive *bool  `json:"is_active,omitempty"`
-	Address  string `json:"address,omitempty"`
-	Currency string `json:"currency,omitempty"`
+	Name     string           `json:"name,omitempty"`
+	IsActive *bool            `json:"is_active,omitempty"`
+	Address  Nullable[string] `json:"address,omitzero"`
+	Currency string           `json:"currency,omitempty"`
 }
 
 // Update updates a client.
-func (s *ClientsService) Update(ctx context.Context, clientID int64, client *ClientUpdateRequest) (*Client, error) {
+func (s *ClientsService) Update(ctx context.Context, clientID ClientID, client *ClientUpdateRequest) (*Client, error) {
 	return Update[Client](ctx, s.client, fmt.Sprintf("clients/%d", clientID), client)
 }
 
+// Archive deactivates a client.
+func (s *ClientsService) Archive(ctx context.Context, clientID ClientID) (*Client, error) {
+	return s.Update(ctx, clientID, &ClientUpdateRequest{IsActive: Bool(false)})
+}
+
+// Restore reactivates an archived client.
+func (s *ClientsService) Restore(ctx context.Context, clientID ClientID) (*Client, error) {
+	return s.Update(ctx, clientID, &ClientUpdateRequest{IsActive: Bool(true)})
+}
+
 // Delete deletes a client.
-func (s *ClientsService) Delete(ctx context.Context, clientID int64) error {
+func (s *ClientsService) Delete(ctx context.Context, clientID ClientID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("clients/%d", clientID))
 }
 
@@ -125,8 +161,7 @@ type ContactsService struct {
 // ContactListOptions specifies optional parameters to the List method.
 type ContactListOptions struct {
 	ListOptions
-	ClientID     int64  `url:"client_id,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	ClientID ClientID `url:"client_id,omitempty"`
 }
 
 // ContactList represents a list of contacts.
@@ -135,32 +170,40 @@ type ContactList struct {
 	Paginated[Contact]
 }
 
+func (l *ContactList) page() *Paginated[Contact] {
+	l.Contacts = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Contacts and Items.
+func (l *ContactList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "contacts", &l.Paginated, &l.Contacts)
+}
+
 // ListPage returns a single page of contacts.
 func (s *ContactsService) ListPage(ctx context.Context, opts *ContactListOptions) (*ContactList, error) {
-	u, err := addOptions("contacts", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Contact, ContactList](ctx, s.client, "contacts", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all contacts across all pages.
+func (s *ContactsService) List(ctx context.Context, opts *ContactListOptions) ([]Contact, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var contacts ContactList
-	_, err = s.client.Do(ctx, req, &contacts)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *ContactsService) ListWithTotals(ctx context.Context, opts *ContactListOptions) (*ListResult[Contact], error) {
+	if opts == nil {
+		opts = &ContactListOptions{}
 	}
-
-	// Copy contacts to Items for pagination
-	contacts.Items = contacts.Contacts
-
-	return &contacts, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Contact, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all contacts across all pages.
-func (s *ContactsService) List(ctx context.Context, opts *ContactListOptions) ([]Contact, error) {
+// All returns an iterator over all contacts, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *ContactsService) All(ctx context.Context, opts *ContactListOptions) iter.Seq2[Contact, error] {
 	if opts == nil {
 		opts = &ContactListOptions{}
 	}
@@ -171,41 +214,48 @@ func (s *ContactsService) List(ctx context.Context, opts *ContactListOptions) ([
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allContacts []Contact
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allContacts = append(allContacts, result.Contacts...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Contact](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*ContactList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all contacts on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ContactsService) Stream(ctx context.Context, opts *ContactListOptions) (<-chan Contact, <-chan error) {
+	if opts == nil {
+		opts = &ContactListOptions{}
 	}
-
-	return allContacts, nil
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // GetContact retrieves a specific contact.
-func (s *ContactsService) Get(ctx context.Context, contactID int64) (*Contact, error) {
+func (s *ContactsService) Get(ctx context.Context, contactID ContactID) (*Contact, error) {
 	return Get[Contact](ctx, s.client, fmt.Sprintf("contacts/%d", contactID))
 }
 
 // ContactCreateRequest represents a request to create a contact.
 type ContactCreateRequest struct {
-	ClientID    int64  `json:"client_id"`
-	FirstName   string `json:"first_name"`
-	LastName    string `json:"last_name,omitempty"`
-	Title       string `json:"title,omitempty"`
-	Email       string `json:"email,omitempty"`
-	PhoneOffice string `json:"phone_office,omitempty"`
-	PhoneMobile string `json:"phone_mobile,omitempty"`
-	Fax         string `json:"fax,omitempty"`
+	ClientID    ClientID `json:"client_id"`
+	FirstName   string   `json:"first_name"`
+	LastName    string   `json:"last_name,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	PhoneOffice string   `json:"phone_office,omitempty"`
+	PhoneMobile string   `json:"phone_mobile,omitempty"`
+	Fax         string   `json:"fax,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *ContactCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ClientID != 0, "client_id", "is required")
+	errs.require(r.FirstName != "", "first_name", "is required")
+	return errs.err()
 }
 
 // CreateContact creates a new contact.
@@ -215,22 +265,22 @@ func (s *ContactsService) Create(ctx context.Context, contact *ContactCreateRequ
 
 // ContactUpdateRequest represents a request to update a contact.
 type ContactUpdateRequest struct {
-	ClientID    int64  `json:"client_id,omitempty"`
-	FirstName   string `json:"first_name,omitempty"`
-	LastName    string `json:"last_name,omitempty"`
-	Title       string `json:"title,omitempty"`
-	Email       string `json:"email,omitempty"`
-	PhoneOffice string `json:"phone_office,omitempty"`
-	PhoneMobile string `json:"phone_mobile,omitempty"`
-	Fax         string `json:"fax,omitempty"`
+	ClientID    ClientID         `json:"client_id,omitempty"`
+	FirstName   string           `json:"first_name,omitempty"`
+	LastName    Nullable[string] `json:"last_name,omitzero"`
+	Title       Nullable[string] `json:"title,omitzero"`
+	Email       Nullable[string] `json:"email,omitzero"`
+	PhoneOffice Nullable[string] `json:"phone_office,omitzero"`
+	PhoneMobile Nullable[string] `json:"phone_mobile,omitzero"`
+	Fax         Nullable[string] `json:"fax,omitzero"`
 }
 
 // UpdateContact updates a contact.
-func (s *ContactsService) Update(ctx context.Context, contactID int64, contact *ContactUpdateRequest) (*Contact, error) {
+func (s *ContactsService) Update(ctx context.Context, contactID ContactID, contact *ContactUpdateRequest) (*Contact, error) {
 	return Update[Contact](ctx, s.client, fmt.Sprintf("contacts/%d", contactID), contact)
 }
 
 // DeleteContact deletes a contact.
-func (s *ContactsService) Delete(ctx context.Context, contactID int64) error {
+func (s *ContactsService) Delete(ctx context.Context, contactID ContactID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("contacts/%d", contactID))
 }
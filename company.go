@@ -2,15 +2,39 @@ package harvest
 
 import (
 	"context"
+	"sync"
 )
 
 // CompanyService handles communication with the company related
 // methods of the Harvest API.
 type CompanyService struct {
 	client *API
+
+	mu     sync.Mutex
+	cached *Company
 }
 
 // Get retrieves the company for the currently authenticated user.
 func (s *CompanyService) Get(ctx context.Context) (*Company, error) {
-	return Get[Company](ctx, s.client, "company")
+	company, err := Get[Company](ctx, s.client, "company")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.cached = company
+	s.mu.Unlock()
+	return company, nil
+}
+
+// Cached returns the company as last retrieved by Get, calling Get on
+// first use. Account settings rarely change, so helpers that only need
+// them for checks use this to avoid a request per call.
+func (s *CompanyService) Cached(ctx context.Context) (*Company, error) {
+	s.mu.Lock()
+	company := s.cached
+	s.mu.Unlock()
+	if company != nil {
+		return company, nil
+	}
+	return s.Get(ctx)
 }
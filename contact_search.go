@@ -0,0 +1,36 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ListForClient returns all contacts of a client.
+func (s *ContactsService) ListForClient(ctx context.Context, clientID ClientID) ([]Contact, error) {
+	return s.List(ctx, &ContactListOptions{ClientID: clientID})
+}
+
+// FindByEmail returns the contact with the given email address, ignoring
+// case. The API has no email filter, so contacts are listed to find it. It
+// returns an error wrapping ErrNotFound when no contact has that address
+// and ErrAmbiguousName when contacts of several clients share it.
+func (s *ContactsService) FindByEmail(ctx context.Context, email string) (*Contact, error) {
+	var found *Contact
+	for contact, err := range s.All(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if email == "" || !strings.EqualFold(contact.Email, email) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%w: contact email %q", ErrAmbiguousName, email)
+		}
+		found = &contact
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: contact email %q", ErrNotFound, email)
+	}
+	return found, nil
+}
@@ -0,0 +1,369 @@
+package harvest
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// timeEntryCSVHeader follows the column layout of Harvest's detailed time
+// export, without the role columns the API doesn't return.
+var timeEntryCSVHeader = []string{
+	"Date", "Client", "Project", "Project Code", "Task", "Notes",
+	"Hours", "Hours Rounded", "Billable?", "Invoiced?", "Approved?", "First Name", "Last Name",
+	"Billable Rate", "Billable Amount", "Cost Rate", "Cost Amount", "Currency",
+	"External Reference URL",
+}
+
+// expenseCSVHeader follows the column layout of Harvest's detailed expense
+// export, without the role columns the API doesn't return.
+var expenseCSVHeader = []string{
+	"Date", "Client", "Project", "Project Code", "Expense Category", "Notes",
+	"Amount", "Units", "Billable?", "Invoiced?", "Approved?", "First Name", "Last Name",
+	"Currency", "Receipt URL",
+}
+
+// WriteTimeEntriesCSV writes entries to w as CSV in the column layout of
+// Harvest's detailed time export.
+func WriteTimeEntriesCSV(w io.Writer, entries []TimeEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(timeEntryCSVHeader); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		first, last := userNames(e.User)
+		var billableAmount, costAmount string
+		if e.Billable && e.BillableRate != nil {
+			billableAmount = e.RoundedHours.Mul(*e.BillableRate).StringFixed(2)
+		}
+		if e.CostRate != nil {
+			costAmount = e.Hours.Mul(*e.CostRate).StringFixed(2)
+		}
+		var refURL string
+		if e.ExternalReference != nil {
+			refURL = e.ExternalReference.Permalink
+		}
+		record := []string{
+			e.SpentDate.String(), clientName(e.Client), projectName(e.Project), projectCode(e.Project),
+			taskName(e.Task), e.Notes, e.Hours.String(), e.RoundedHours.String(),
+			yesNo(e.Billable), yesNo(e.IsBilled), yesNo(e.ApprovalStatus == ApprovalStatusApproved), first, last,
+			decimalString(e.BillableRate), billableAmount, decimalString(e.CostRate), costAmount,
+			clientCurrency(e.Client), refURL,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteExpensesCSV writes expenses to w as CSV in the column layout of
+// Harvest's detailed expense export.
+func WriteExpensesCSV(w io.Writer, expenses []Expense) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(expenseCSVHeader); err != nil {
+		return err
+	}
+	for _, e := range expenses {
+		first, last := userNames(e.User)
+		var category, receiptURL string
+		if e.ExpenseCategory != nil {
+			category = e.ExpenseCategory.Name
+		}
+		if e.Receipt != nil {
+			receiptURL = e.Receipt.URL
+		}
+		record := []string{
+			e.SpentDate.String(), clientName(e.Client), projectName(e.Project), projectCode(e.Project),
+			category, e.Notes, e.TotalCost.StringFixed(2), decimalString(e.Units),
+			yesNo(e.Billable), yesNo(e.IsBilled), yesNo(e.ApprovalStatus == ApprovalStatusApproved), first, last,
+			clientCurrency(e.Client), receiptURL,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// CSVLookup resolves the names in an imported CSV to IDs. Fill it from the
+// corresponding List calls. Names are matched case-insensitively.
+type CSVLookup struct {
+	Projects          []Project
+	Tasks             []Task
+	Users             []User
+	ExpenseCategories []ExpenseCategory
+}
+
+// CSVRecord is one data row read from a CSV. Err is a *ValidationError
+// naming the columns that could not be used, or nil if Request is ready to
+// send.
+type CSVRecord[T any] struct {
+	// Line is the row's line number in the file, counting the header as 1.
+	Line    int
+	Request T
+	Err     error
+}
+
+// ReadTimeEntriesCSV reads time entries in the layout written by
+// WriteTimeEntriesCSV, or any CSV with Date, Project, Task and Hours
+// columns, and returns one create request per row. Client, Notes, First
+// Name and Last Name are used when present; rows without a user are
+// created for the current user. Problems with individual rows are reported
+// in their CSVRecord; the error is only set when the CSV itself can't be
+// read.
+func ReadTimeEntriesCSV(r io.Reader, lookup *CSVLookup) ([]CSVRecord[TimeEntryCreateViaDurationRequest], error) {
+	return readCSV(r, []string{"Date", "Project", "Task", "Hours"}, func(row csvRow) (TimeEntryCreateViaDurationRequest, error) {
+		var req TimeEntryCreateViaDurationRequest
+		var errs fieldErrors
+		req.SpentDate = row.get("Date")
+		errs.require(req.SpentDate != "", "Date", "is required")
+		errs.date(req.SpentDate, "Date")
+		req.Notes = row.get("Notes")
+		req.ProjectID = lookup.project(row.get("Client"), row.get("Project"), &errs)
+		req.TaskID = lookup.task(row.get("Task"), &errs)
+		req.UserID = lookup.user(row.get("First Name"), row.get("Last Name"), &errs)
+		req.Hours = row.decimal("Hours", &errs)
+		if len(errs) > 0 {
+			return req, errs.err()
+		}
+		return req, req.Validate()
+	})
+}
+
+// ReadExpensesCSV reads expenses in the layout written by WriteExpensesCSV,
+// or any CSV with Date, Project, Expense Category and either Amount or
+// Units columns, and returns one create request per row. Client, Notes,
+// Billable?, First Name and Last Name are used when present. Problems with
+// individual rows are reported in their CSVRecord; the error is only set
+// when the CSV itself can't be read.
+func ReadExpensesCSV(r io.Reader, lookup *CSVLookup) ([]CSVRecord[ExpenseCreateRequest], error) {
+	return readCSV(r, []string{"Date", "Project", "Expense Category"}, func(row csvRow) (ExpenseCreateRequest, error) {
+		var req ExpenseCreateRequest
+		var errs fieldErrors
+		req.SpentDate = row.get("Date")
+		errs.require(req.SpentDate != "", "Date", "is required")
+		errs.date(req.SpentDate, "Date")
+		req.Notes = row.get("Notes")
+		req.ProjectID = lookup.project(row.get("Client"), row.get("Project"), &errs)
+		req.ExpenseCategoryID = lookup.expenseCategory(row.get("Expense Category"), &errs)
+		req.UserID = lookup.user(row.get("First Name"), row.get("Last Name"), &errs)
+		if units := row.get("Units"); units != "" {
+			v := row.decimal("Units", &errs)
+			req.Units = &v
+		} else {
+			v := row.decimal("Amount", &errs)
+			req.TotalCost = &v
+		}
+		if billable := row.get("Billable?"); billable != "" {
+			req.Billable = Bool(strings.EqualFold(billable, "yes"))
+		}
+		if len(errs) > 0 {
+			return req, errs.err()
+		}
+		return req, req.Validate()
+	})
+}
+
+// csvRow is a data row with its columns looked up by header name.
+type csvRow struct {
+	columns map[string]int
+	record  []string
+}
+
+// get returns the trimmed value of column, or "" if the CSV has no such
+// column.
+func (r csvRow) get(column string) string {
+	if i, ok := r.columns[column]; ok && i < len(r.record) {
+		return strings.TrimSpace(r.record[i])
+	}
+	return ""
+}
+
+// decimal parses column as a decimal, recording an error if it is invalid.
+func (r csvRow) decimal(column string, errs *fieldErrors) decimal.Decimal {
+	v, err := decimal.NewFromString(r.get(column))
+	errs.require(err == nil, column, "is not a number")
+	return v
+}
+
+// readCSV reads a CSV with a header row containing at least required and
+// converts each data row with parse.
+func readCSV[T any](r io.Reader, required []string, parse func(csvRow) (T, error)) ([]CSVRecord[T], error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("harvest: CSV is missing the %q column", name)
+		}
+	}
+
+	var records []CSVRecord[T]
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		req, err := parse(csvRow{columns: columns, record: record})
+		records = append(records, CSVRecord[T]{Line: line, Request: req, Err: err})
+	}
+}
+
+// project returns the ID of the project named name, narrowed by client
+// name when given.
+func (l *CSVLookup) project(client, name string, errs *fieldErrors) ProjectID {
+	var found []ProjectID
+	for _, p := range l.Projects {
+		if strings.EqualFold(p.Name, name) && (client == "" || p.Client == nil || strings.EqualFold(p.Client.Name, client)) {
+			found = append(found, p.ID)
+		}
+	}
+	errs.require(len(found) > 0, "Project", fmt.Sprintf("no project named %q", name))
+	errs.require(len(found) < 2, "Project", fmt.Sprintf("%q matches more than one project; add the Client column", name))
+	if len(found) != 1 {
+		return 0
+	}
+	return found[0]
+}
+
+// task returns the ID of the task named name.
+func (l *CSVLookup) task(name string, errs *fieldErrors) TaskID {
+	for _, t := range l.Tasks {
+		if strings.EqualFold(t.Name, name) {
+			return t.ID
+		}
+	}
+	errs.require(false, "Task", fmt.Sprintf("no task named %q", name))
+	return 0
+}
+
+// expenseCategory returns the ID of the expense category named name.
+func (l *CSVLookup) expenseCategory(name string, errs *fieldErrors) ExpenseCategoryID {
+	for _, c := range l.ExpenseCategories {
+		if strings.EqualFold(c.Name, name) {
+			return c.ID
+		}
+	}
+	errs.require(false, "Expense Category", fmt.Sprintf("no expense category named %q", name))
+	return 0
+}
+
+// user returns the ID of the user with the given names, or 0 when both are
+// empty. Users are matched on their full name, so a name split differently
+// across the First Name and Last Name columns still matches.
+func (l *CSVLookup) user(first, last string, errs *fieldErrors) UserID {
+	name := normalizeName(first + " " + last)
+	if name == "" {
+		return 0
+	}
+	var found []UserID
+	for _, u := range l.Users {
+		if strings.EqualFold(userFullName(&u), name) {
+			found = append(found, u.ID)
+		}
+	}
+	errs.require(len(found) > 0, "First Name", fmt.Sprintf("no user named %q", name))
+	errs.require(len(found) < 2, "First Name", fmt.Sprintf("%q matches more than one user", name))
+	if len(found) != 1 {
+		return 0
+	}
+	return found[0]
+}
+
+// userNames returns a user's first and last names. Users nested in time
+// entries and expenses only carry a full name, which is split at the first
+// space; CSVLookup rejoins the names before matching, so the split doesn't
+// need to match Harvest's.
+func userNames(u *User) (first, last string) {
+	if u == nil {
+		return "", ""
+	}
+	if u.FirstName != "" || u.LastName != "" {
+		return u.FirstName, u.LastName
+	}
+	first, last, _ = strings.Cut(normalizeName(u.Name), " ")
+	return first, last
+}
+
+// userFullName returns a user's normalized full name.
+func userFullName(u *User) string {
+	if u == nil {
+		return ""
+	}
+	if u.FirstName != "" || u.LastName != "" {
+		return normalizeName(u.FirstName + " " + u.LastName)
+	}
+	return normalizeName(u.Name)
+}
+
+// normalizeName trims name and collapses runs of whitespace to one space.
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func clientName(c *Client) string {
+	if c == nil {
+		return ""
+	}
+	return c.Name
+}
+
+func clientCurrency(c *Client) string {
+	if c == nil {
+		return ""
+	}
+	return c.Currency
+}
+
+func projectName(p *Project) string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
+
+func projectCode(p *Project) string {
+	if p == nil {
+		return ""
+	}
+	return p.Code
+}
+
+func taskName(t *Task) string {
+	if t == nil {
+		return ""
+	}
+	return t.Name
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+func decimalString(d *decimal.Decimal) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
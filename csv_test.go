@@ -0,0 +1,62 @@
+package harvest
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadTimeEntriesCSVMatchesFullName(t *testing.T) {
+	lookup := &CSVLookup{
+		Projects: []Project{{ID: 14307913, Name: "Marketing Website"}},
+		Tasks:    []Task{{ID: 8083365, Name: "Design"}},
+		Users: []User{
+			{ID: 1782959, FirstName: "Mary Ann", LastName: "Smith"},
+			{ID: 1782884, FirstName: "Kim", LastName: "Allen"},
+		},
+	}
+
+	tests := []struct {
+		first, last string
+		want        UserID
+	}{
+		{"Mary Ann", "Smith", 1782959},
+		// The export splits nested users' names at the first space.
+		{"Mary", "Ann Smith", 1782959},
+		{" mary  ann", "SMITH ", 1782959},
+		{"Kim", "Allen", 1782884},
+		{"", "", 0},
+	}
+	for _, tt := range tests {
+		csv := "Date,Project,Task,Hours,First Name,Last Name\n2025-01-06,Marketing Website,Design,1.5," + tt.first + "," + tt.last + "\n"
+		records, err := ReadTimeEntriesCSV(strings.NewReader(csv), lookup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) != 1 {
+			t.Fatalf("got %d records, want 1", len(records))
+		}
+		if err := records[0].Err; err != nil {
+			t.Errorf("%q %q: %v", tt.first, tt.last, err)
+			continue
+		}
+		if got := records[0].Request.UserID; got != tt.want {
+			t.Errorf("%q %q: UserID = %d, want %d", tt.first, tt.last, got, tt.want)
+		}
+	}
+}
+
+func TestReadTimeEntriesCSVUnknownUser(t *testing.T) {
+	lookup := &CSVLookup{
+		Projects: []Project{{ID: 14307913, Name: "Marketing Website"}},
+		Tasks:    []Task{{ID: 8083365, Name: "Design"}},
+		Users:    []User{{ID: 1782959, FirstName: "Mary Ann", LastName: "Smith"}},
+	}
+	csv := "Date,Project,Task,Hours,First Name,Last Name\n2025-01-06,Marketing Website,Design,1.5,Mary,Smith\n"
+	records, err := ReadTimeEntriesCSV(strings.NewReader(csv), lookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Err == nil {
+		t.Fatalf("records = %+v, want a user error", records)
+	}
+}
@@ -0,0 +1,64 @@
+package harvest
+
+import "slices"
+
+// Codes of commonly used currencies, for the Currency fields of clients,
+// invoices and estimates. ValidCurrency accepts every currency Harvest
+// supports, not only these.
+const (
+	CurrencyAUD = "AUD"
+	CurrencyBRL = "BRL"
+	CurrencyCAD = "CAD"
+	CurrencyCHF = "CHF"
+	CurrencyCNY = "CNY"
+	CurrencyDKK = "DKK"
+	CurrencyEUR = "EUR"
+	CurrencyGBP = "GBP"
+	CurrencyHKD = "HKD"
+	CurrencyINR = "INR"
+	CurrencyJPY = "JPY"
+	CurrencyMXN = "MXN"
+	CurrencyNOK = "NOK"
+	CurrencyNZD = "NZD"
+	CurrencyPLN = "PLN"
+	CurrencySEK = "SEK"
+	CurrencySGD = "SGD"
+	CurrencyUSD = "USD"
+	CurrencyZAR = "ZAR"
+)
+
+// currencies are the ISO 4217 codes Harvest accepts, sorted.
+var currencies = []string{
+	"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN",
+	"BAM", "BBD", "BDT", "BGN", "BHD", "BIF", "BMD", "BND", "BOB", "BRL",
+	"BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHF", "CLP", "CNY",
+	"COP", "CRC", "CUP", "CVE", "CZK", "DJF", "DKK", "DOP", "DZD", "EGP",
+	"ERN", "ETB", "EUR", "FJD", "FKP", "GBP", "GEL", "GHS", "GIP", "GMD",
+	"GNF", "GTQ", "GYD", "HKD", "HNL", "HTG", "HUF", "IDR", "ILS", "INR",
+	"IQD", "IRR", "ISK", "JMD", "JOD", "JPY", "KES", "KGS", "KHR", "KMF",
+	"KPW", "KRW", "KWD", "KYD", "KZT", "LAK", "LBP", "LKR", "LRD", "LSL",
+	"LYD", "MAD", "MDL", "MGA", "MKD", "MMK", "MNT", "MOP", "MRU", "MUR",
+	"MVR", "MWK", "MXN", "MYR", "MZN", "NAD", "NGN", "NIO", "NOK", "NPR",
+	"NZD", "OMR", "PAB", "PEN", "PGK", "PHP", "PKR", "PLN", "PYG", "QAR",
+	"RON", "RSD", "RUB", "RWF", "SAR", "SBD", "SCR", "SDG", "SEK", "SGD",
+	"SHP", "SLE", "SOS", "SRD", "SSP", "STN", "SVC", "SYP", "SZL", "THB",
+	"TJS", "TMT", "TND", "TOP", "TRY", "TTD", "TWD", "TZS", "UAH", "UGX",
+	"USD", "UYU", "UZS", "VES", "VND", "VUV", "WST", "XAF", "XCD", "XOF",
+	"XPF", "YER", "ZAR", "ZMW", "ZWL",
+}
+
+// ValidCurrency reports whether code is a currency Harvest supports. Codes
+// are upper case, as Harvest requires.
+func ValidCurrency(code string) bool {
+	_, ok := slices.BinarySearch(currencies, code)
+	return ok
+}
+
+// currency records an error for field when value is set but not a
+// supported currency code.
+func (f *fieldErrors) currency(value, field string) {
+	if value == "" {
+		return
+	}
+	f.require(ValidCurrency(value), field, "is not a supported ISO 4217 currency code")
+}
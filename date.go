@@ -0,0 +1,163 @@
+package harvest
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dateLayout is the format Harvest uses for dates.
+const dateLayout = "2006-01-02"
+
+// NewDate returns the date for the given year, month and day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the calendar date of t in t's location.
+func DateOf(t time.Time) Date {
+	return NewDate(t.Date())
+}
+
+// Today returns the current date in loc. Harvest dates are in the
+// account's or user's timezone, so pass that location rather than relying
+// on the server's.
+func Today(loc *time.Location) Date {
+	return DateOf(time.Now().In(loc))
+}
+
+// ParseDate parses a date in YYYY-MM-DD format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{t}, nil
+}
+
+// AddDays returns the date n days after d. n may be negative.
+func (d Date) AddDays(n int) Date {
+	return Date{d.AddDate(0, 0, n)}
+}
+
+// BeforeDate reports whether d is before other. It is named so as not to
+// hide the Before method of the embedded time.Time.
+func (d Date) BeforeDate(other Date) bool {
+	return d.Time.Before(other.Time)
+}
+
+// AfterDate reports whether d is after other.
+func (d Date) AfterDate(other Date) bool {
+	return d.Time.After(other.Time)
+}
+
+// EqualDate reports whether d and other are the same date.
+func (d Date) EqualDate(other Date) bool {
+	return d.Time.Equal(other.Time)
+}
+
+// Scan implements sql.Scanner. It accepts time.Time values, strings and
+// byte slices in YYYY-MM-DD format, and NULL, which leaves d zero.
+func (d *Date) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+	case time.Time:
+		*d = DateOf(v)
+	case string:
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	default:
+		return fmt.Errorf("harvest: cannot scan %T into Date", src)
+	}
+	return nil
+}
+
+func (d *Date) scanString(s string) error {
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing the date as YYYY-MM-DD. The zero
+// Date is stored as NULL.
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// TimeOfDay is a wall clock time without a date, as used for the start and
+// end times of time entries.
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// clockLayouts are the formats Harvest uses for started_time and
+// ended_time, depending on the account's 12- or 24-hour clock setting.
+var clockLayouts = []string{"3:04pm", "3:04 pm", "15:04"}
+
+// ParseTimeOfDay parses a time in either of Harvest's clock formats, such
+// as "9:30am" or "09:30".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	for _, layout := range clockLayouts {
+		if t, err := time.Parse(layout, strings.ToLower(strings.TrimSpace(s))); err == nil {
+			return TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}, nil
+		}
+	}
+	return TimeOfDay{}, fmt.Errorf("harvest: unrecognized time %q", s)
+}
+
+// TimeOfDayOf returns the wall clock time of t in t's location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}
+}
+
+// On returns the time t on date d in loc.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	y, m, day := d.Date()
+	return time.Date(y, m, day, t.Hour, t.Minute, 0, 0, loc)
+}
+
+// Format formats t for an account's clock setting: "3:04pm" for "12h" and
+// "15:04" otherwise.
+func (t TimeOfDay) Format(clock string) string {
+	layout := "15:04"
+	if clock == "12h" {
+		layout = "3:04pm"
+	}
+	return time.Date(0, 1, 1, t.Hour, t.Minute, 0, 0, time.UTC).Format(layout)
+}
+
+// String returns t in 24-hour "15:04" format.
+func (t TimeOfDay) String() string {
+	return t.Format("24h")
+}
+
+// MarshalJSON implements json.Marshaler, writing t in 24-hour format.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either of Harvest's
+// clock formats.
+func (t *TimeOfDay) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,26 @@
+package harvest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateComparisons(t *testing.T) {
+	d := NewDate(2026, time.October, 16)
+	next := d.AddDays(1)
+	if !d.BeforeDate(next) || d.BeforeDate(d) {
+		t.Error("BeforeDate is not strict")
+	}
+	if !next.AfterDate(d) || d.AfterDate(d) {
+		t.Error("AfterDate is not strict")
+	}
+	if !d.EqualDate(NewDate(2026, time.October, 16)) || d.EqualDate(next) {
+		t.Error("EqualDate compares the wrong dates")
+	}
+
+	// Date still has time.Time's methods.
+	noon := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+	if !d.Before(noon) || d.After(noon) || d.Equal(noon) {
+		t.Error("time.Time comparisons on Date changed")
+	}
+}
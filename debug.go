@@ -0,0 +1,73 @@
+package harvest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+const redacted = "REDACTED"
+
+// redactedHeaders lists request headers whose values are never written to debug output.
+var redactedHeaders = []string{"Authorization", "Harvest-Account-Id", "Forecast-Account-Id"}
+
+// dumpRequest writes req to the debug writer with credentials redacted.
+// The request body is read through GetBody. A body without GetBody, such as
+// one set by a caller of Do, is buffered and req.Body and req.GetBody are
+// replaced so the request can still be sent.
+func (c *API) dumpRequest(req *http.Request) {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			req.Body = io.NopCloser(errReader{err})
+			fmt.Fprintf(c.debug, "harvest: unable to read request body: %v\n", err)
+			return
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	clone := req.Clone(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			fmt.Fprintf(c.debug, "harvest: unable to read request body: %v\n", err)
+			return
+		}
+		clone.Body = body
+	}
+	for _, h := range redactedHeaders {
+		if clone.Header.Get(h) != "" {
+			clone.Header.Set(h, redacted)
+		}
+	}
+
+	dump, err := httputil.DumpRequestOut(clone, clone.Body != nil)
+	if err != nil {
+		fmt.Fprintf(c.debug, "harvest: unable to dump request: %v\n", err)
+		return
+	}
+	fmt.Fprintf(c.debug, "%s\n\n", dump)
+}
+
+// dumpResponse writes resp to the debug writer. The body is buffered and
+// replaced so it can still be decoded afterwards.
+func (c *API) dumpResponse(resp *http.Response) {
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		fmt.Fprintf(c.debug, "harvest: unable to dump response: %v\n", err)
+		return
+	}
+	fmt.Fprintf(c.debug, "%s\n\n", dump)
+}
+
+// errReader fails every read with err, so that a request whose body could
+// not be buffered for dumping fails when sent rather than going out empty.
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
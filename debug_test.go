@@ -0,0 +1,39 @@
+package harvest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDebugDumpKeepsCallerBody(t *testing.T) {
+	var received string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		received = string(data)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	var debug bytes.Buffer
+	c := newTestClient(t, handler, WithDebug(&debug))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, c.baseURL.String()+"echo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A body without GetBody, as a caller of Do might set it.
+	req.Body = io.NopCloser(strings.NewReader(`{"a":1}`))
+	req.ContentLength = 7
+
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if received != `{"a":1}` {
+		t.Errorf("server received %q, want the request body", received)
+	}
+	if !strings.Contains(debug.String(), `{"a":1}`) {
+		t.Errorf("debug output is missing the body:\n%s", debug.String())
+	}
+}
@@ -0,0 +1,17 @@
+// Package harvest is a client for the Harvest API v2.
+//
+// # Stability
+//
+// The package surface is split into tiers so applications can judge how
+// safely they can upgrade:
+//
+//   - Stable: the API client, its services, request and response types,
+//     pagination, and errors. These follow semantic versioning; anything
+//     scheduled for removal is first marked Deprecated and keeps working
+//     for at least one minor release, logging a warning through the logger
+//     set with WithLogger each time a deprecated call is first used.
+//   - Experimental: higher-level helpers layered on the services, such as
+//     report trends and snapshots, mapping rules, and token sources, along
+//     with the fixtures and examples directories. Their doc comments say
+//     "Experimental" and they may change in minor releases.
+package harvest
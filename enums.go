@@ -0,0 +1,147 @@
+package harvest
+
+import "slices"
+
+// InvoiceState is the state of an invoice.
+type InvoiceState string
+
+const (
+	InvoiceStateDraft  InvoiceState = "draft"
+	InvoiceStateOpen   InvoiceState = "open"
+	InvoiceStatePaid   InvoiceState = "paid"
+	InvoiceStateClosed InvoiceState = "closed"
+)
+
+// Valid reports whether s is a known invoice state.
+func (s InvoiceState) Valid() bool {
+	return slices.Contains([]InvoiceState{InvoiceStateDraft, InvoiceStateOpen, InvoiceStatePaid, InvoiceStateClosed}, s)
+}
+
+// EstimateState is the state of an estimate.
+type EstimateState string
+
+const (
+	EstimateStateDraft    EstimateState = "draft"
+	EstimateStateSent     EstimateState = "sent"
+	EstimateStateAccepted EstimateState = "accepted"
+	EstimateStateDeclined EstimateState = "declined"
+)
+
+// Valid reports whether s is a known estimate state.
+func (s EstimateState) Valid() bool {
+	return slices.Contains([]EstimateState{EstimateStateDraft, EstimateStateSent, EstimateStateAccepted, EstimateStateDeclined}, s)
+}
+
+// BillBy is how a project's billable amounts are calculated.
+type BillBy string
+
+const (
+	BillByProject BillBy = "Project"
+	BillByTasks   BillBy = "Tasks"
+	BillByPeople  BillBy = "People"
+	BillByNone    BillBy = "none"
+)
+
+// Valid reports whether b is a known bill-by method.
+func (b BillBy) Valid() bool {
+	return slices.Contains([]BillBy{BillByProject, BillByTasks, BillByPeople, BillByNone}, b)
+}
+
+// BudgetBy is how a project's budget is tracked.
+type BudgetBy string
+
+const (
+	BudgetByProject     BudgetBy = "project"
+	BudgetByProjectCost BudgetBy = "project_cost"
+	BudgetByTask        BudgetBy = "task"
+	BudgetByTaskFees    BudgetBy = "task_fees"
+	BudgetByPerson      BudgetBy = "person"
+	BudgetByNone        BudgetBy = "none"
+)
+
+// Valid reports whether b is a known budget-by method.
+func (b BudgetBy) Valid() bool {
+	return slices.Contains([]BudgetBy{BudgetByProject, BudgetByProjectCost, BudgetByTask, BudgetByTaskFees, BudgetByPerson, BudgetByNone}, b)
+}
+
+// LineItemKind is the kind of an invoice or estimate line item: the name of
+// an item category. Accounts start with Service and Product but can define
+// their own categories, so any non-empty kind is accepted.
+type LineItemKind string
+
+const (
+	LineItemKindService LineItemKind = "Service"
+	LineItemKindProduct LineItemKind = "Product"
+)
+
+// WeekStartDay is the day an account's week starts on.
+type WeekStartDay string
+
+const (
+	WeekStartSaturday WeekStartDay = "Saturday"
+	WeekStartSunday   WeekStartDay = "Sunday"
+	WeekStartMonday   WeekStartDay = "Monday"
+)
+
+// Valid reports whether d is a known week start day.
+func (d WeekStartDay) Valid() bool {
+	return slices.Contains([]WeekStartDay{WeekStartSaturday, WeekStartSunday, WeekStartMonday}, d)
+}
+
+// PaymentOption is an online payment method offered on an invoice.
+type PaymentOption string
+
+const (
+	PaymentOptionACH        PaymentOption = "ach"
+	PaymentOptionCreditCard PaymentOption = "credit_card"
+	PaymentOptionPayPal     PaymentOption = "paypal"
+)
+
+// Valid reports whether o is a known payment option.
+func (o PaymentOption) Valid() bool {
+	return slices.Contains([]PaymentOption{PaymentOptionACH, PaymentOptionCreditCard, PaymentOptionPayPal}, o)
+}
+
+// ApprovalStatus is where a time entry or expense is in the approval
+// workflow. Entries waiting for approval, shown as pending in Harvest, are
+// submitted.
+type ApprovalStatus string
+
+const (
+	ApprovalStatusUnsubmitted ApprovalStatus = "unsubmitted"
+	ApprovalStatusSubmitted   ApprovalStatus = "submitted"
+	ApprovalStatusApproved    ApprovalStatus = "approved"
+)
+
+// Valid reports whether s is a known approval status.
+func (s ApprovalStatus) Valid() bool {
+	return slices.Contains([]ApprovalStatus{ApprovalStatusUnsubmitted, ApprovalStatusSubmitted, ApprovalStatusApproved}, s)
+}
+
+// AccessRole is a user's permission level, or for managers an extra
+// permission. Every user has exactly one of administrator, manager or
+// member; the others only apply to managers.
+type AccessRole string
+
+const (
+	AccessRoleAdministrator                 AccessRole = "administrator"
+	AccessRoleManager                       AccessRole = "manager"
+	AccessRoleMember                        AccessRole = "member"
+	AccessRoleProjectCreator                AccessRole = "project_creator"
+	AccessRoleBillableRatesManager          AccessRole = "billable_rates_manager"
+	AccessRoleManagedProjectsInvoiceDrafter AccessRole = "managed_projects_invoice_drafter"
+	AccessRoleManagedProjectsInvoiceManager AccessRole = "managed_projects_invoice_manager"
+	AccessRoleClientAndTaskManager          AccessRole = "client_and_task_manager"
+	AccessRoleTimeAndExpensesManager        AccessRole = "time_and_expenses_manager"
+	AccessRoleEstimatesManager              AccessRole = "estimates_manager"
+)
+
+// Valid reports whether r is a known access role.
+func (r AccessRole) Valid() bool {
+	return slices.Contains([]AccessRole{
+		AccessRoleAdministrator, AccessRoleManager, AccessRoleMember,
+		AccessRoleProjectCreator, AccessRoleBillableRatesManager,
+		AccessRoleManagedProjectsInvoiceDrafter, AccessRoleManagedProjectsInvoiceManager,
+		AccessRoleClientAndTaskManager, AccessRoleTimeAndExpensesManager, AccessRoleEstimatesManager,
+	}, r)
+}
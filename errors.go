@@ -2,11 +2,23 @@ package harvest
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 )
 
+// Sentinel errors wrapped by the errors returned from CheckResponse, so callers
+// can write errors.Is(err, harvest.ErrNotFound) and still use errors.As to get
+// the *ErrorResponse or *RateLimitError for details.
+var (
+	ErrUnauthorized = errors.New("harvest: unauthorized")
+	ErrForbidden    = errors.New("harvest: forbidden")
+	ErrNotFound     = errors.New("harvest: not found")
+	ErrValidation   = errors.New("harvest: validation failed")
+	ErrRateLimited  = errors.New("harvest: rate limited")
+)
+
 // ErrorResponse represents an error response from the Harvest API.
 type ErrorResponse struct {
 	Response *http.Response
@@ -15,17 +27,46 @@ type ErrorResponse struct {
 		Field   string `json:"field"`
 		Message string `json:"message"`
 	} `json:"error_description,omitempty"`
+
+	requestID string
+	body      []byte
 }
 
 func (e *ErrorResponse) Error() string {
-	if len(e.Errors) > 0 {
-		msg := fmt.Sprintf("%v %v: %d %s", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message)
-		for _, err := range e.Errors {
-			msg += fmt.Sprintf("\n  %s: %s", err.Field, err.Message)
-		}
-		return msg
+	msg := fmt.Sprintf("%v %v: %d %s", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message)
+	if e.requestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.requestID)
+	}
+	for _, err := range e.Errors {
+		msg += fmt.Sprintf("\n  %s: %s", err.Field, err.Message)
 	}
-	return fmt.Sprintf("%v %v: %d %s", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message)
+	return msg
+}
+
+// RequestID returns the X-Request-Id header Harvest sent with the error
+// response, for correlating failures with Harvest support.
+func (e *ErrorResponse) RequestID() string {
+	return e.requestID
+}
+
+// RawBody returns the error response body exactly as Harvest sent it.
+func (e *ErrorResponse) RawBody() []byte {
+	return e.body
+}
+
+// Unwrap returns the sentinel error matching the response status code, if any.
+func (e *ErrorResponse) Unwrap() error {
+	switch e.Response.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
 }
 
 // RateLimitError occurs when the API rate limit is exceeded.
@@ -46,6 +87,11 @@ func (e *RateLimitError) Error() string {
 		e.Rate.Reset.Time.Format("15:04:05"))
 }
 
+// Unwrap returns ErrRateLimited.
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
 // CheckResponse checks the API response for errors.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
@@ -61,9 +107,13 @@ func CheckResponse(r *http.Response) error {
 		}
 	}
 
-	errorResponse := &ErrorResponse{Response: r}
+	errorResponse := &ErrorResponse{
+		Response:  r,
+		requestID: r.Header.Get("X-Request-Id"),
+	}
 	data, err := io.ReadAll(r.Body)
 	if err == nil && data != nil {
+		errorResponse.body = data
 		json.Unmarshal(data, errorResponse)
 	}
 
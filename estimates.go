@@ -2,8 +2,12 @@ package harvest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"net/url"
+	"iter"
+	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // EstimatesService handles communication with the estimate related
@@ -15,11 +19,26 @@ type EstimatesService struct {
 // EstimateListOptions specifies optional parameters to the List method.
 type EstimateListOptions struct {
 	ListOptions
-	ClientID     int64  `url:"client_id,omitempty"`
-	State        string `url:"state,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
-	From         string `url:"from,omitempty"`
-	To           string `url:"to,omitempty"`
+	ClientID ClientID      `url:"client_id,omitempty"`
+	State    EstimateState `url:"state,omitempty"`
+	// From and To bound the issue date, in YYYY-MM-DD format. See
+	// IssuedBetween and IssuedInMonth.
+	From string `url:"from,omitempty"`
+	To   string `url:"to,omitempty"`
+}
+
+// IssuedBetween limits the list to estimates issued from from to to,
+// inclusive. A zero Date leaves that end of the range open.
+func (o *EstimateListOptions) IssuedBetween(from, to Date) *EstimateListOptions {
+	o.From = dateString(from)
+	o.To = dateString(to)
+	return o
+}
+
+// IssuedInMonth limits the list to estimates issued in the given month.
+func (o *EstimateListOptions) IssuedInMonth(year int, month time.Month) *EstimateListOptions {
+	first := NewDate(year, month, 1)
+	return o.IssuedBetween(first, Date{first.AddDate(0, 1, -1)})
 }
 
 // EstimateList represents a list of estimates.
@@ -28,32 +47,40 @@ type EstimateList struct {
 	Paginated[Estimate]
 }
 
+func (l *EstimateList) page() *Paginated[Estimate] {
+	l.Estimates = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Estimates and Items.
+func (l *EstimateList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "estimates", &l.Paginated, &l.Estimates)
+}
+
 // ListPage returns a single page of estimates.
 func (s *EstimatesService) ListPage(ctx context.Context, opts *EstimateListOptions) (*EstimateList, error) {
-	u, err := addOptions("estimates", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Estimate, EstimateList](ctx, s.client, "estimates", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all estimates across all pages.
+func (s *EstimatesService) List(ctx context.Context, opts *EstimateListOptions) ([]Estimate, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var estimates EstimateList
-	_, err = s.client.Do(ctx, req, &estimates)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *EstimatesService) ListWithTotals(ctx context.Context, opts *EstimateListOptions) (*ListResult[Estimate], error) {
+	if opts == nil {
+		opts = &EstimateListOptions{}
 	}
-
-	// Copy estimates to Items for pagination
-	estimates.Items = estimates.Estimates
-
-	return &estimates, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Estimate, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all estimates across all pages.
-func (s *EstimatesService) List(ctx context.Context, opts *EstimateListOptions) ([]Estimate, error) {
+// All returns an iterator over all estimates, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *EstimatesService) All(ctx context.Context, opts *EstimateListOptions) iter.Seq2[Estimate, error] {
 	if opts == nil {
 		opts = &EstimateListOptions{}
 	}
@@ -64,39 +91,38 @@ func (s *EstimatesService) List(ctx context.Context, opts *EstimateListOptions)
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allEstimates []Estimate
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allEstimates = append(allEstimates, result.Estimates...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Estimate](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*EstimateList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all estimates on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *EstimatesService) Stream(ctx context.Context, opts *EstimateListOptions) (<-chan Estimate, <-chan error) {
+	if opts == nil {
+		opts = &EstimateListOptions{}
 	}
-
-	return allEstimates, nil
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // Get retrieves a specific estimate.
-func (s *EstimatesService) Get(ctx context.Context, estimateID int64) (*Estimate, error) {
+func (s *EstimatesService) Get(ctx context.Context, estimateID EstimateID) (*Estimate, error) {
 	return Get[Estimate](ctx, s.client, fmt.Sprintf("estimates/%d", estimateID))
 }
 
 // EstimateCreateRequest represents a request to create an estimate.
 type EstimateCreateRequest struct {
-	ClientID      int64                     `json:"client_id"`
+	ClientID      ClientID                  `json:"client_id"`
 	Number        string                    `json:"number,omitempty"`
 	PurchaseOrder string                    `json:"purchase_order,omitempty"`
-	Tax           float64                   `json:"tax,omitempty"`
-	Tax2          float64                   `json:"tax2,omitempty"`
-	Discount      float64                   `json:"discount,omitempty"`
+	Tax           *decimal.Decimal          `json:"tax,omitempty"`
+	Tax2          *decimal.Decimal          `json:"tax2,omitempty"`
+	Discount      *decimal.Decimal          `json:"discount,omitempty"`
 	Subject       string                    `json:"subject,omitempty"`
 	Notes         string                    `json:"notes,omitempty"`
 	Currency      string                    `json:"currency,omitempty"`
@@ -104,14 +130,53 @@ type EstimateCreateRequest struct {
 	LineItems     []EstimateLineItemRequest `json:"line_items,omitempty"`
 }
 
+// SetIssueDate sets the issue date from a Date. A zero Date leaves it
+// unset.
+func (r *EstimateCreateRequest) SetIssueDate(issueDate Date) *EstimateCreateRequest {
+	r.IssueDate = dateString(issueDate)
+	return r
+}
+
+// Validate checks that the required fields are set.
+func (r *EstimateCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ClientID != 0, "client_id", "is required")
+	errs.currency(r.Currency, "currency")
+	errs.date(r.IssueDate, "issue_date")
+	for i, item := range r.LineItems {
+		errs.lineItem(i, item.ID, item.Kind, item.Destroy)
+	}
+	return errs.err()
+}
+
 // EstimateLineItemRequest represents a line item in an estimate request.
+// In an update, items with an ID change that existing line item, items
+// without one are added, and line items that aren't listed are kept.
 type EstimateLineItemRequest struct {
-	Kind        string  `json:"kind"`
-	Description string  `json:"description"`
-	Quantity    float64 `json:"quantity"`
-	UnitPrice   float64 `json:"unit_price"`
-	Taxed       *bool   `json:"taxed,omitempty"`
-	Taxed2      *bool   `json:"taxed2,omitempty"`
+	// ID is the line item to update or remove. Leave it zero to add a line
+	// item.
+	ID          int64           `json:"id,omitempty"`
+	Kind        LineItemKind    `json:"kind,omitempty"`
+	Description string          `json:"description"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Taxed       *bool           `json:"taxed,omitempty"`
+	Taxed2      *bool           `json:"taxed2,omitempty"`
+	// Destroy removes the line item with ID; the other fields are not sent.
+	Destroy bool `json:"_destroy,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, sending only the ID of line items
+// being removed.
+func (r EstimateLineItemRequest) MarshalJSON() ([]byte, error) {
+	if r.Destroy {
+		return json.Marshal(struct {
+			ID      int64 `json:"id"`
+			Destroy bool  `json:"_destroy"`
+		}{r.ID, true})
+	}
+	type plain EstimateLineItemRequest
+	return json.Marshal(plain(r))
 }
 
 // Create creates a new estimate.
@@ -121,53 +186,131 @@ func (s *EstimatesService) Create(ctx context.Context, estimate *EstimateCreateR
 
 // EstimateUpdateRequest represents a request to update an estimate.
 type EstimateUpdateRequest struct {
-	ClientID      int64                     `json:"client_id,omitempty"`
+	ClientID      ClientID                  `json:"client_id,omitempty"`
 	Number        string                    `json:"number,omitempty"`
-	PurchaseOrder string                    `json:"purchase_order,omitempty"`
-	Tax           float64                   `json:"tax,omitempty"`
-	Tax2          float64                   `json:"tax2,omitempty"`
-	Discount      float64                   `json:"discount,omitempty"`
-	Subject       string                    `json:"subject,omitempty"`
-	Notes         string                    `json:"notes,omitempty"`
+	PurchaseOrder Nullable[string]          `json:"purchase_order,omitzero"`
+	Tax           Nullable[decimal.Decimal] `json:"tax,omitzero"`
+	Tax2          Nullable[decimal.Decimal] `json:"tax2,omitzero"`
+	Discount      Nullable[decimal.Decimal] `json:"discount,omitzero"`
+	Subject       Nullable[string]          `json:"subject,omitzero"`
+	Notes         Nullable[string]          `json:"notes,omitzero"`
 	Currency      string                    `json:"currency,omitempty"`
 	IssueDate     string                    `json:"issue_date,omitempty"`
 	LineItems     []EstimateLineItemRequest `json:"line_items,omitempty"`
 }
 
+// SetIssueDate sets the issue date from a Date. A zero Date leaves it
+// unchanged.
+func (r *EstimateUpdateRequest) SetIssueDate(issueDate Date) *EstimateUpdateRequest {
+	r.IssueDate = dateString(issueDate)
+	return r
+}
+
+// Validate checks the currency and issue date, and that line items being added have a
+// kind and line items being removed have an ID.
+func (r *EstimateUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.currency(r.Currency, "currency")
+	errs.date(r.IssueDate, "issue_date")
+	for i, item := range r.LineItems {
+		errs.lineItem(i, item.ID, item.Kind, item.Destroy)
+	}
+	return errs.err()
+}
+
 // Update updates an estimate.
-func (s *EstimatesService) Update(ctx context.Context, estimateID int64, estimate *EstimateUpdateRequest) (*Estimate, error) {
+func (s *EstimatesService) Update(ctx context.Context, estimateID EstimateID, estimate *EstimateUpdateRequest) (*Estimate, error) {
 	return Update[Estimate](ctx, s.client, fmt.Sprintf("estimates/%d", estimateID), estimate)
 }
 
+// AddLineItem adds a line item to an estimate, leaving its other line
+// items as they are.
+func (s *EstimatesService) AddLineItem(ctx context.Context, estimateID EstimateID, item EstimateLineItemRequest) (*Estimate, error) {
+	item.ID = 0
+	item.Destroy = false
+	return s.Update(ctx, estimateID, &EstimateUpdateRequest{LineItems: []EstimateLineItemRequest{item}})
+}
+
+// UpdateLineItem replaces the description, quantity and unit price of one
+// line item, and its kind and taxes when set in item, leaving the
+// estimate's other line items as they are.
+func (s *EstimatesService) UpdateLineItem(ctx context.Context, estimateID EstimateID, lineItemID int64, item EstimateLineItemRequest) (*Estimate, error) {
+	item.ID = lineItemID
+	item.Destroy = false
+	return s.Update(ctx, estimateID, &EstimateUpdateRequest{LineItems: []EstimateLineItemRequest{item}})
+}
+
+// RemoveLineItem removes one line item from an estimate, leaving its other
+// line items as they are.
+func (s *EstimatesService) RemoveLineItem(ctx context.Context, estimateID EstimateID, lineItemID int64) (*Estimate, error) {
+	return s.Update(ctx, estimateID, &EstimateUpdateRequest{
+		LineItems: []EstimateLineItemRequest{{ID: lineItemID, Destroy: true}},
+	})
+}
+
 // Delete deletes an estimate.
-func (s *EstimatesService) Delete(ctx context.Context, estimateID int64) error {
+func (s *EstimatesService) Delete(ctx context.Context, estimateID EstimateID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("estimates/%d", estimateID))
 }
 
-// MarkAsSent marks an estimate as sent.
-func (s *EstimatesService) MarkAsSent(ctx context.Context, estimateID int64) (*Estimate, error) {
-	return Update[Estimate](ctx, s.client, fmt.Sprintf("estimates/%d/messages", estimateID), nil)
+// EstimateMessageCreateRequest represents a request to create an estimate
+// message. Without an EventType, the estimate is emailed to Recipients and
+// marked as sent. With one of "send", "accept", "decline" or "re-open",
+// nothing is emailed and the estimate's state changes accordingly.
+type EstimateMessageCreateRequest struct {
+	EventType   string                     `json:"event_type,omitempty"`
+	Recipients  []EstimateMessageRecipient `json:"recipients,omitempty"`
+	Subject     string                     `json:"subject,omitempty"`
+	Body        string                     `json:"body,omitempty"`
+	SendMeACopy bool                       `json:"send_me_a_copy,omitempty"`
+}
+
+// Validate checks that emailed messages have recipients.
+func (r *EstimateMessageCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.EventType != "" || len(r.Recipients) > 0, "recipients", "is required")
+	for i, recipient := range r.Recipients {
+		errs.require(recipient.Email != "", fmt.Sprintf("recipients[%d].email", i), "is required")
+	}
+	return errs.err()
+}
+
+// Send creates a message for an estimate, emailing it to the recipients or
+// recording the event in message.EventType.
+func (s *EstimatesService) Send(ctx context.Context, estimateID EstimateID, message *EstimateMessageCreateRequest) (*EstimateMessage, error) {
+	return Create[EstimateMessage](ctx, s.client, fmt.Sprintf("estimates/%d/messages", estimateID), message)
+}
+
+// MarkAsSent marks a draft estimate as sent without emailing it.
+//
+// Deprecated: Use Send with EventType "send", which returns the message
+// created. MarkAsSent sends it and then retrieves the estimate.
+func (s *EstimatesService) MarkAsSent(ctx context.Context, estimateID EstimateID) (*Estimate, error) {
+	s.client.warnDeprecated("EstimatesService.MarkAsSent", "EstimatesService.Send")
+	if _, err := s.Send(ctx, estimateID, &EstimateMessageCreateRequest{EventType: "send"}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, estimateID)
 }
 
 // MarkAsAccepted marks an estimate as accepted.
-func (s *EstimatesService) MarkAsAccepted(ctx context.Context, estimateID int64) (*Estimate, error) {
+func (s *EstimatesService) MarkAsAccepted(ctx context.Context, estimateID EstimateID) (*Estimate, error) {
 	return Update[Estimate](ctx, s.client, fmt.Sprintf("estimates/%d/accept", estimateID), nil)
 }
 
 // MarkAsDeclined marks an estimate as declined.
-func (s *EstimatesService) MarkAsDeclined(ctx context.Context, estimateID int64) (*Estimate, error) {
+func (s *EstimatesService) MarkAsDeclined(ctx context.Context, estimateID EstimateID) (*Estimate, error) {
 	return Update[Estimate](ctx, s.client, fmt.Sprintf("estimates/%d/decline", estimateID), nil)
 }
 
 // Reopen reopens a closed estimate.
-func (s *EstimatesService) Reopen(ctx context.Context, estimateID int64) (*Estimate, error) {
+func (s *EstimatesService) Reopen(ctx context.Context, estimateID EstimateID) (*Estimate, error) {
 	return Update[Estimate](ctx, s.client, fmt.Sprintf("estimates/%d/reopen", estimateID), nil)
 }
 
 // EstimateItemCategoryListOptions specifies optional parameters for listing estimate item categories.
 type EstimateItemCategoryListOptions struct {
 	ListOptions
-	UpdatedSince string `url:"updated_since,omitempty"`
 }
 
 // EstimateItemCategoryList represents a list of estimate item categories.
@@ -176,33 +319,42 @@ type EstimateItemCategoryList struct {
 	Paginated[EstimateItemCategory]
 }
 
+func (l *EstimateItemCategoryList) page() *Paginated[EstimateItemCategory] {
+	l.EstimateItemCategories = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both EstimateItemCategories and Items.
+func (l *EstimateItemCategoryList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "estimate_item_categories", &l.Paginated, &l.EstimateItemCategories)
+}
+
 // ListItemCategoriesPage returns a single page of estimate item categories.
 func (s *EstimatesService) ListItemCategoriesPage(ctx context.Context, opts *EstimateItemCategoryListOptions) (*EstimateItemCategoryList, error) {
-	u, err := addOptions("estimate_item_categories", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[EstimateItemCategory, EstimateItemCategoryList](ctx, s.client, "estimate_item_categories", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// ListItemCategories returns all estimate item categories across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *EstimatesService) ListItemCategories(ctx context.Context, opts *EstimateItemCategoryListOptions) ([]EstimateItemCategory, error) {
+	return collect(s.AllItemCategories(ctx, opts))
+}
 
-	var categories EstimateItemCategoryList
-	_, err = s.client.Do(ctx, req, &categories)
-	if err != nil {
-		return nil, err
+// ListItemCategoriesWithTotals is like ListItemCategories but also returns the totals and rate
+// limit reported by the API.
+func (s *EstimatesService) ListItemCategoriesWithTotals(ctx context.Context, opts *EstimateItemCategoryListOptions) (*ListResult[EstimateItemCategory], error) {
+	if opts == nil {
+		opts = &EstimateItemCategoryListOptions{}
 	}
-
-	// Copy categories to Items for pagination
-	categories.Items = categories.EstimateItemCategories
-
-	return &categories, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[EstimateItemCategory, error] {
+		return s.AllItemCategories(ctx, opts)
+	})
 }
 
-// ListItemCategories returns all estimate item categories across all pages.
+// AllItemCategories returns an iterator over all estimate item categories, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
 // This endpoint uses cursor-based pagination.
-func (s *EstimatesService) ListItemCategories(ctx context.Context, opts *EstimateItemCategoryListOptions) ([]EstimateItemCategory, error) {
+func (s *EstimatesService) AllItemCategories(ctx context.Context, opts *EstimateItemCategoryListOptions) iter.Seq2[EstimateItemCategory, error] {
 	if opts == nil {
 		opts = &EstimateItemCategoryListOptions{}
 	}
@@ -211,60 +363,27 @@ func (s *EstimatesService) ListItemCategories(ctx context.Context, opts *Estimat
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allCategories []EstimateItemCategory
+	return all[EstimateItemCategory](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*EstimateItemCategoryList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListItemCategoriesPage(ctx, &o)
+	})
+}
 
-	// Fetch first page
-	result, err := s.ListItemCategoriesPage(ctx, opts)
-	if err != nil {
-		return nil, err
-	}
-	allCategories = append(allCategories, result.EstimateItemCategories...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Parse the URL to get path and query
-			u, err := url.Parse(nextURL)
-			if err != nil {
-				return nil, err
-			}
-			pathAndQuery := u.Path
-			if u.RawQuery != "" {
-				pathAndQuery += "?" + u.RawQuery
-			}
-
-			req, err := s.client.NewRequest(ctx, "GET", pathAndQuery, nil)
-			if err != nil {
-				return nil, err
-			}
-
-			var categories EstimateItemCategoryList
-			_, err = s.client.Do(ctx, req, &categories)
-			if err != nil {
-				return nil, err
-			}
-			categories.Items = categories.EstimateItemCategories
-			result = &categories
-			allCategories = append(allCategories, categories.EstimateItemCategories...)
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = s.ListItemCategoriesPage(ctx, opts)
-			if err != nil {
-				return nil, err
-			}
-			allCategories = append(allCategories, result.EstimateItemCategories...)
-		} else {
-			break
-		}
+// StreamItemCategories sends all estimate item categories on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *EstimatesService) StreamItemCategories(ctx context.Context, opts *EstimateItemCategoryListOptions) (<-chan EstimateItemCategory, <-chan error) {
+	if opts == nil {
+		opts = &EstimateItemCategoryListOptions{}
 	}
-
-	return allCategories, nil
+	seq := s.AllItemCategories(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // GetItemCategory retrieves a specific estimate item category.
-func (s *EstimatesService) GetItemCategory(ctx context.Context, categoryID int64) (*EstimateItemCategory, error) {
+func (s *EstimatesService) GetItemCategory(ctx context.Context, categoryID EstimateItemCategoryID) (*EstimateItemCategory, error) {
 	return Get[EstimateItemCategory](ctx, s.client, fmt.Sprintf("estimate_item_categories/%d", categoryID))
 }
 
@@ -273,6 +392,13 @@ type EstimateItemCategoryCreateRequest struct {
 	Name string `json:"name"`
 }
 
+// Validate checks that the required fields are set.
+func (r *EstimateItemCategoryCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Name != "", "name", "is required")
+	return errs.err()
+}
+
 // CreateItemCategory creates a new estimate item category.
 func (s *EstimatesService) CreateItemCategory(ctx context.Context, category *EstimateItemCategoryCreateRequest) (*EstimateItemCategory, error) {
 	return Create[EstimateItemCategory](ctx, s.client, "estimate_item_categories", category)
@@ -284,11 +410,11 @@ type EstimateItemCategoryUpdateRequest struct {
 }
 
 // UpdateItemCategory updates an estimate item category.
-func (s *EstimatesService) UpdateItemCategory(ctx context.Context, categoryID int64, category *EstimateItemCategoryUpdateRequest) (*EstimateItemCategory, error) {
+func (s *EstimatesService) UpdateItemCategory(ctx context.Context, categoryID EstimateItemCategoryID, category *EstimateItemCategoryUpdateRequest) (*EstimateItemCategory, error) {
 	return Update[EstimateItemCategory](ctx, s.client, fmt.Sprintf("estimate_item_categories/%d", categoryID), category)
 }
 
 // DeleteItemCategory deletes an estimate item category.
-func (s *EstimatesService) DeleteItemCategory(ctx context.Context, categoryID int64) error {
+func (s *EstimatesService) DeleteItemCategory(ctx context.Context, categoryID EstimateItemCategoryID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("estimate_item_categories/%d", categoryID))
 }
@@ -0,0 +1,124 @@
+// Command jirasync creates Harvest time entries from Jira worklogs.
+//
+// It reads worklogs as JSON from stdin, in the shape returned by Jira's
+// worklog API with the issue key added, and routes each one to a Harvest
+// project and task using a mapping file:
+//
+//	jirasync -mapping mapping.json < worklogs.json
+//
+// A mapping file routes Jira project keys to Harvest projects and tasks:
+//
+//	{
+//	  "rules": [
+//	    {"source": "jira", "match": "WEB-*", "project_id": 14307913, "task_id": 8083365}
+//	  ],
+//	  "fallback": {"project_id": 14308069, "task_id": 8083366}
+//	}
+//
+// Worklogs already synced are skipped by looking up their external reference.
+// HARVEST_ACCESS_TOKEN and HARVEST_ACCOUNT_ID must be set.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/joefitzgerald/harvest"
+)
+
+type worklog struct {
+	ID               string `json:"id"`
+	IssueKey         string `json:"issueKey"`
+	Self             string `json:"self"`
+	Comment          string `json:"comment"`
+	Started          string `json:"started"`
+	TimeSpentSeconds int64  `json:"timeSpentSeconds"`
+}
+
+func main() {
+	mappingFile := flag.String("mapping", "mapping.json", "project/task mapping configuration")
+	flag.Parse()
+
+	data, err := os.ReadFile(*mappingFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mapper, err := harvest.ParseMappingConfig(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := harvest.New("Harvest Jira Sync Example (https://github.com/joefitzgerald/harvest)")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := run(context.Background(), client, mapper, os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run syncs the worklogs read from r, reporting each one to w.
+func run(ctx context.Context, client *harvest.API, mapper *harvest.Mapper, r io.Reader, w io.Writer) error {
+	var worklogs []worklog
+	if err := json.NewDecoder(r).Decode(&worklogs); err != nil {
+		return err
+	}
+
+	for _, wl := range worklogs {
+		existing, err := client.TimeEntries.ListByExternalReference(ctx, jiraService(wl.Self), wl.IssueKey, wl.ID)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			fmt.Fprintf(w, "skip    %s worklog %s (time entry %d)\n", wl.IssueKey, wl.ID, existing[0].ID)
+			continue
+		}
+
+		target, err := mapper.Resolve("jira", wl.IssueKey)
+		if err != nil {
+			log.Printf("%s: %v", wl.IssueKey, err)
+			continue
+		}
+
+		started, err := time.Parse("2006-01-02T15:04:05.000-0700", wl.Started)
+		if err != nil {
+			return fmt.Errorf("%s worklog %s: %w", wl.IssueKey, wl.ID, err)
+		}
+
+		entry, err := client.TimeEntries.CreateViaDuration(ctx, &harvest.TimeEntryCreateViaDurationRequest{
+			ProjectID: target.ProjectID,
+			TaskID:    target.TaskID,
+			SpentDate: harvest.DateOf(started).String(),
+			Hours:     harvest.DurationToHours(time.Duration(wl.TimeSpentSeconds)*time.Second, 2),
+			Notes:     fmt.Sprintf("%s: %s", wl.IssueKey, wl.Comment),
+			ExternalReference: &harvest.ExternalReferenceRequest{
+				ID:        wl.ID,
+				GroupID:   wl.IssueKey,
+				Permalink: wl.Self,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("%s worklog %s: %w", wl.IssueKey, wl.ID, err)
+		}
+		fmt.Fprintf(w, "created %s worklog %s (time entry %d)\n", wl.IssueKey, wl.ID, entry.ID)
+	}
+	return nil
+}
+
+// jiraService returns the service Harvest records for a time entry whose
+// external reference links to permalink: the host of the Jira site.
+func jiraService(permalink string) string {
+	u, err := url.Parse(permalink)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/joefitzgerald/harvest"
+)
+
+// newTestClient returns a client whose requests are served by handler.
+func newTestClient(t *testing.T, handler http.Handler) *harvest.API {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL + "/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := harvest.NewWithConfig("token", "123", "jirasync-test", srv.Client(), harvest.WithBaseURL(u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+const worklogs = `[
+  {"id": "10001", "issueKey": "WEB-1", "self": "https://example.atlassian.net/rest/api/2/issue/WEB-1/worklog/10001", "comment": "Layout", "started": "2025-01-06T09:00:00.000+0000", "timeSpentSeconds": 3600},
+  {"id": "10002", "issueKey": "WEB-2", "self": "https://example.atlassian.net/rest/api/2/issue/WEB-2/worklog/10002", "comment": "Checkout", "started": "2025-01-07T09:00:00.000+0000", "timeSpentSeconds": 5400}
+]`
+
+func TestRun(t *testing.T) {
+	var created []map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/company", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"wants_timestamp_timers": false}`)
+	})
+	mux.HandleFunc("GET /v2/time_entries", func(w http.ResponseWriter, r *http.Request) {
+		entries := "[]"
+		// WEB-1 was synced from another Jira site first, then from this one.
+		if r.URL.Query().Get("external_reference_id") == "10001" {
+			entries = `[
+			  {"id": 500, "external_reference": {"id": "10001", "group_id": "WEB-1", "service": "other.atlassian.net"}},
+			  {"id": 501, "external_reference": {"id": "10001", "group_id": "WEB-1", "service": "example.atlassian.net"}}
+			]`
+		}
+		io.WriteString(w, `{"time_entries": `+entries+`, "page": 1, "total_pages": 1, "total_entries": 0}`)
+	})
+	mux.HandleFunc("POST /v2/time_entries", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		created = append(created, body)
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": 900}`)
+	})
+
+	mapper, err := harvest.ParseMappingConfig([]byte(`{"rules": [{"source": "jira", "match": "WEB-*", "project_id": 14307913, "task_id": 8083365}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	if err := run(context.Background(), newTestClient(t, mux), mapper, strings.NewReader(worklogs), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "skip    WEB-1 worklog 10001 (time entry 501)\n" +
+		"created WEB-2 worklog 10002 (time entry 900)\n"
+	if out.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if len(created) != 1 {
+		t.Fatalf("created %d time entries, want 1", len(created))
+	}
+	if got := created[0]["project_id"]; got != float64(14307913) {
+		t.Errorf("project_id = %v", got)
+	}
+	if got := created[0]["spent_date"]; got != "2025-01-07" {
+		t.Errorf("spent_date = %v", got)
+	}
+	ref, _ := created[0]["external_reference"].(map[string]any)
+	if ref["id"] != "10002" || ref["group_id"] != "WEB-2" {
+		t.Errorf("external_reference = %v", ref)
+	}
+}
@@ -0,0 +1,106 @@
+// Command uninvoiced creates a draft invoice for every client with
+// uninvoiced work in a period.
+//
+// Usage:
+//
+//	uninvoiced -from 2025-01-01 -to 2025-01-31 [-min 100] [-dry-run]
+//
+// HARVEST_ACCESS_TOKEN and HARVEST_ACCOUNT_ID must be set.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/joefitzgerald/harvest"
+	"github.com/shopspring/decimal"
+)
+
+func main() {
+	from := flag.String("from", "", "start of the period (YYYY-MM-DD)")
+	to := flag.String("to", "", "end of the period (YYYY-MM-DD)")
+	minimum := flag.Float64("min", 0, "skip clients whose uninvoiced amount is below this")
+	dryRun := flag.Bool("dry-run", false, "print the invoices instead of creating them")
+	flag.Parse()
+
+	if *from == "" || *to == "" {
+		flag.Usage()
+		return
+	}
+
+	client, err := harvest.New("Harvest Uninvoiced Example (https://github.com/joefitzgerald/harvest)")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := run(context.Background(), client, *from, *to, decimal.NewFromFloat(*minimum), *dryRun, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run drafts an invoice for each client with at least minimum uninvoiced
+// between from and to, reporting each one to w.
+func run(ctx context.Context, client *harvest.API, from, to string, minimum decimal.Decimal, dryRun bool, w io.Writer) error {
+	var rows []harvest.UninvoicedReport
+	opts := &harvest.UninvoicedReportOptions{From: from, To: to}
+	for row, err := range client.Reports.AllUninvoicedReports(ctx, opts) {
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	// Group project rows by client so each client gets a single invoice.
+	var order []harvest.ClientID
+	byClient := make(map[harvest.ClientID][]harvest.UninvoicedReport)
+	for _, row := range rows {
+		if row.UninvoicedAmount.IsZero() {
+			continue
+		}
+		if _, ok := byClient[row.ClientID]; !ok {
+			order = append(order, row.ClientID)
+		}
+		byClient[row.ClientID] = append(byClient[row.ClientID], row)
+	}
+
+	for _, clientID := range order {
+		projects := byClient[clientID]
+
+		total := decimal.Zero
+		req := &harvest.InvoiceCreateRequest{
+			ClientID: clientID,
+			Currency: projects[0].Currency,
+			Subject:  fmt.Sprintf("Services %s to %s", from, to),
+		}
+		for _, p := range projects {
+			total = total.Add(p.UninvoicedAmount)
+			req.LineItems = append(req.LineItems, harvest.InvoiceLineItemRequest{
+				ProjectID:   p.ProjectID,
+				Kind:        harvest.LineItemKindService,
+				Description: fmt.Sprintf("%s (%s hours)", p.ProjectName, p.UninvoicedHours.StringFixed(2)),
+				Quantity:    decimal.NewFromInt(1),
+				UnitPrice:   p.UninvoicedAmount,
+			})
+		}
+
+		if total.LessThan(minimum) {
+			fmt.Fprintf(w, "skip  %-30s %s %s\n", projects[0].ClientName, total.StringFixed(2), projects[0].Currency)
+			continue
+		}
+		if dryRun {
+			fmt.Fprintf(w, "draft %-30s %s %s\n", projects[0].ClientName, total.StringFixed(2), projects[0].Currency)
+			continue
+		}
+
+		invoice, err := client.Invoices.Create(ctx, req)
+		if err != nil {
+			return fmt.Errorf("%s: %w", projects[0].ClientName, err)
+		}
+		fmt.Fprintf(w, "draft %-30s %s %s (invoice %d)\n", projects[0].ClientName, invoice.Amount.StringFixed(2), invoice.Currency, invoice.ID)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/joefitzgerald/harvest"
+	"github.com/shopspring/decimal"
+)
+
+// newTestClient returns a client whose requests are served by handler.
+func newTestClient(t *testing.T, handler http.Handler) *harvest.API {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL + "/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := harvest.NewWithConfig("token", "123", "uninvoiced-test", srv.Client(), harvest.WithBaseURL(u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+const uninvoicedReport = `{
+  "results": [
+    {"client_id": 5735776, "client_name": "123 Industries", "project_id": 14307913, "project_name": "Marketing Website", "currency": "USD", "uninvoiced_hours": 10, "uninvoiced_amount": 1000},
+    {"client_id": 5735774, "client_name": "ABC Corp", "project_id": 14308069, "project_name": "Online Store - Phase 1", "currency": "EUR", "uninvoiced_hours": 0.5, "uninvoiced_amount": 50},
+    {"client_id": 5735776, "client_name": "123 Industries", "project_id": 14808188, "project_name": "Mobile App", "currency": "USD", "uninvoiced_hours": 5, "uninvoiced_amount": 500},
+    {"client_id": 5735777, "client_name": "Idle Co", "project_id": 14808189, "project_name": "Retainer", "currency": "USD", "uninvoiced_hours": 0, "uninvoiced_amount": 0}
+  ],
+  "page": 1,
+  "total_pages": 1,
+  "total_entries": 4
+}`
+
+func newServer(t *testing.T, invoices *[]map[string]any) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/reports/uninvoiced", func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query(); q.Get("from") != "2025-01-01" || q.Get("to") != "2025-01-31" {
+			t.Errorf("report period = %s to %s", q.Get("from"), q.Get("to"))
+		}
+		io.WriteString(w, uninvoicedReport)
+	})
+	mux.HandleFunc("POST /v2/invoices", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		*invoices = append(*invoices, body)
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": 13150403, "amount": 1500, "currency": "USD"}`)
+	})
+	return mux
+}
+
+func TestRun(t *testing.T) {
+	var invoices []map[string]any
+	client := newTestClient(t, newServer(t, &invoices))
+
+	var out strings.Builder
+	if err := run(context.Background(), client, "2025-01-01", "2025-01-31", decimal.NewFromInt(100), false, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "draft 123 Industries                 1500.00 USD (invoice 13150403)\n" +
+		"skip  ABC Corp                       50.00 EUR\n"
+	if out.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if len(invoices) != 1 {
+		t.Fatalf("created %d invoices, want 1", len(invoices))
+	}
+	if got := invoices[0]["client_id"]; got != float64(5735776) {
+		t.Errorf("client_id = %v", got)
+	}
+	if items, _ := invoices[0]["line_items"].([]any); len(items) != 2 {
+		t.Errorf("got %d line items, want 2", len(items))
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	var invoices []map[string]any
+	client := newTestClient(t, newServer(t, &invoices))
+
+	var out strings.Builder
+	if err := run(context.Background(), client, "2025-01-01", "2025-01-31", decimal.Zero, true, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "draft 123 Industries                 1500.00 USD\n" +
+		"draft ABC Corp                       50.00 EUR\n"
+	if out.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if len(invoices) != 0 {
+		t.Errorf("created %d invoices in a dry run", len(invoices))
+	}
+}
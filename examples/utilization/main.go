@@ -0,0 +1,89 @@
+// Command utilization reports last week's utilization for every person on
+// the account and optionally emails the summary.
+//
+// Usage:
+//
+//	utilization [-to ops@example.com -from harvest@example.com -smtp smtp.example.com:587]
+//
+// HARVEST_ACCESS_TOKEN and HARVEST_ACCOUNT_ID must be set. When -to is given,
+// SMTP_USERNAME and SMTP_PASSWORD are used to authenticate with the server.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joefitzgerald/harvest"
+)
+
+func main() {
+	to := flag.String("to", "", "email recipient; prints to stdout when empty")
+	from := flag.String("from", "", "email sender")
+	server := flag.String("smtp", "", "SMTP server host:port")
+	flag.Parse()
+
+	client, err := harvest.New("Harvest Utilization Example (https://github.com/joefitzgerald/harvest)")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := run(context.Background(), client, lastWeek(time.Now()), &buf); err != nil {
+		log.Fatal(err)
+	}
+
+	if *to == "" {
+		os.Stdout.Write(buf.Bytes())
+		return
+	}
+
+	host, _, err := net.SplitHostPort(*server)
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth := smtp.PlainAuth("", os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), host)
+	msg := fmt.Sprintf("To: %s\r\nFrom: %s\r\nSubject: Weekly utilization\r\n\r\n%s", *to, *from, buf.String())
+	if err := smtp.SendMail(*server, auth, *from, []string{*to}, []byte(msg)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run writes the utilization table for week to w.
+func run(ctx context.Context, client *harvest.API, week harvest.Period, w io.Writer) error {
+	report, err := client.Reports.Utilization(ctx, week)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Utilization for %s to %s\n\n", week.From, week.To)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "Person\tTotal\tBillable\tCapacity\tUtilization")
+	for _, u := range report.Users {
+		utilization := "n/a"
+		if u.BillableUtilization != nil {
+			utilization = u.BillableUtilization.StringFixed(0) + "%"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", u.UserName, u.TotalHours.StringFixed(2), u.BillableHours.StringFixed(2), u.CapacityHours.StringFixed(2), utilization)
+	}
+	return tw.Flush()
+}
+
+// lastWeek returns the Monday to Sunday week before the one containing now.
+func lastWeek(now time.Time) harvest.Period {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(today.Weekday()) + 6) % 7
+	monday := today.AddDate(0, 0, -offset-7)
+	return harvest.Period{
+		From: harvest.Date{Time: monday},
+		To:   harvest.Date{Time: monday.AddDate(0, 0, 6)},
+	}
+}
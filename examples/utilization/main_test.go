@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joefitzgerald/harvest"
+)
+
+// newTestClient returns a client whose requests are served by handler.
+func newTestClient(t *testing.T, handler http.Handler) *harvest.API {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL + "/v2/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := harvest.NewWithConfig("token", "123", "utilization-test", srv.Client(), harvest.WithBaseURL(u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestRun(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/users", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{
+		  "users": [
+		    {"id": 1782959, "first_name": "Kim", "last_name": "Allen", "weekly_capacity": 144000},
+		    {"id": 1782884, "first_name": "Sam", "last_name": "Lee", "is_contractor": true, "weekly_capacity": 0}
+		  ],
+		  "page": 1, "total_pages": 1, "total_entries": 2
+		}`)
+	})
+	mux.HandleFunc("GET /v2/reports/time/team", func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query(); q.Get("from") != "2025-01-06" || q.Get("to") != "2025-01-12" {
+			t.Errorf("report period = %s to %s", q.Get("from"), q.Get("to"))
+		}
+		io.WriteString(w, `{
+		  "results": [
+		    {"user_id": 1782959, "user_name": "Kim Allen", "weekly_capacity": 144000, "total_hours": 32, "billable_hours": 24, "currency": "USD"},
+		    {"user_id": 1782884, "user_name": "Sam Lee", "is_contractor": true, "total_hours": 10, "billable_hours": 10, "currency": "USD"}
+		  ],
+		  "page": 1, "total_pages": 1, "total_entries": 2
+		}`)
+	})
+
+	week := lastWeek(time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC))
+	var out strings.Builder
+	if err := run(context.Background(), newTestClient(t, mux), week, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "Utilization for 2025-01-06 to 2025-01-12\n\n" +
+		"Person     Total  Billable  Capacity  Utilization\n" +
+		"Kim Allen  32.00  24.00     40.00     60%\n" +
+		"Sam Lee    10.00  10.00     0.00      n/a\n"
+	if out.String() != want {
+		t.Errorf("output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,39 @@
+package harvest
+
+import "github.com/shopspring/decimal"
+
+// IsUnitBased reports whether expenses in the category are entered as a
+// number of units, such as miles, priced at UnitPrice.
+func (c *ExpenseCategory) IsUnitBased() bool {
+	return c.UnitPrice != nil
+}
+
+// Cost returns the total cost of units in a unit-based category, before
+// any rounding Harvest applies. It returns an error wrapping ErrValidation
+// when the category isn't unit-based or units isn't positive.
+func (c *ExpenseCategory) Cost(units decimal.Decimal) (decimal.Decimal, error) {
+	var errs fieldErrors
+	errs.require(c.IsUnitBased(), "expense_category_id", "is not a unit-based category")
+	errs.require(units.IsPositive(), "units", "must be positive")
+	if err := errs.err(); err != nil {
+		return decimal.Decimal{}, err
+	}
+	return units.Mul(*c.UnitPrice), nil
+}
+
+// NewUnitExpense returns a request for an expense of units in a unit-based
+// category, such as mileage. Harvest computes the total cost from the
+// units; Cost returns the amount to expect. It returns an error wrapping
+// ErrValidation when the category isn't unit-based or units isn't
+// positive.
+func NewUnitExpense(category *ExpenseCategory, projectID ProjectID, spentDate Date, units decimal.Decimal) (*ExpenseCreateRequest, error) {
+	if _, err := category.Cost(units); err != nil {
+		return nil, err
+	}
+	return &ExpenseCreateRequest{
+		ProjectID:         projectID,
+		ExpenseCategoryID: category.ID,
+		SpentDate:         dateString(spentDate),
+		Units:             &units,
+	}, nil
+}
@@ -1,8 +1,18 @@
 package harvest
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"iter"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"slices"
+
+	"github.com/shopspring/decimal"
 )
 
 // ExpensesService handles communication with the expense related
@@ -14,14 +24,13 @@ type ExpensesService struct {
 // ExpenseListOptions specifies optional parameters to the List method.
 type ExpenseListOptions struct {
 	ListOptions
-	UserID         int64  `url:"user_id,omitempty"`
-	ClientID       int64  `url:"client_id,omitempty"`
-	ProjectID      int64  `url:"project_id,omitempty"`
-	IsBilled       *bool  `url:"is_billed,omitempty"`
-	ApprovalStatus string `url:"approval_status,omitempty"`
-	UpdatedSince   string `url:"updated_since,omitempty"`
-	From           string `url:"from,omitempty"`
-	To             string `url:"to,omitempty"`
+	UserID         UserID         `url:"user_id,omitempty"`
+	ClientID       ClientID       `url:"client_id,omitempty"`
+	ProjectID      ProjectID      `url:"project_id,omitempty"`
+	IsBilled       *bool          `url:"is_billed,omitempty"`
+	ApprovalStatus ApprovalStatus `url:"approval_status,omitempty"`
+	From           string         `url:"from,omitempty"`
+	To             string         `url:"to,omitempty"`
 }
 
 // ExpenseList represents a list of expenses.
@@ -30,32 +39,40 @@ type ExpenseList struct {
 	Paginated[Expense]
 }
 
+func (l *ExpenseList) page() *Paginated[Expense] {
+	l.Expenses = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Expenses and Items.
+func (l *ExpenseList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "expenses", &l.Paginated, &l.Expenses)
+}
+
 // ListPage returns a single page of expenses.
 func (s *ExpensesService) ListPage(ctx context.Context, opts *ExpenseListOptions) (*ExpenseList, error) {
-	u, err := addOptions("expenses", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Expense, ExpenseList](ctx, s.client, "expenses", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all expenses across all pages.
+func (s *ExpensesService) List(ctx context.Context, opts *ExpenseListOptions) ([]Expense, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var expenses ExpenseList
-	_, err = s.client.Do(ctx, req, &expenses)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *ExpensesService) ListWithTotals(ctx context.Context, opts *ExpenseListOptions) (*ListResult[Expense], error) {
+	if opts == nil {
+		opts = &ExpenseListOptions{}
 	}
-
-	// Copy expenses to Items for pagination
-	expenses.Items = expenses.Expenses
-
-	return &expenses, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Expense, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all expenses across all pages.
-func (s *ExpensesService) List(ctx context.Context, opts *ExpenseListOptions) ([]Expense, error) {
+// All returns an iterator over all expenses, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *ExpensesService) All(ctx context.Context, opts *ExpenseListOptions) iter.Seq2[Expense, error] {
 	if opts == nil {
 		opts = &ExpenseListOptions{}
 	}
@@ -66,41 +83,81 @@ func (s *ExpensesService) List(ctx context.Context, opts *ExpenseListOptions) ([
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allExpenses []Expense
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
+	return all[Expense](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*ExpenseList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		allExpenses = append(allExpenses, result.Expenses...)
+// Stream sends all expenses on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ExpensesService) Stream(ctx context.Context, opts *ExpenseListOptions) (<-chan Expense, <-chan error) {
+	if opts == nil {
+		opts = &ExpenseListOptions{}
+	}
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
 
-		if !result.HasNextPage() {
-			break
-		}
+// ListBetween returns all expenses spent from from to to, inclusive. Other
+// filters in opts are applied too; its From and To are replaced.
+func (s *ExpensesService) ListBetween(ctx context.Context, from, to Date, opts *ExpenseListOptions) ([]Expense, error) {
+	var errs fieldErrors
+	errs.dateRange(from, to)
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
 
-		opts.Page = *result.NextPage
+	var o ExpenseListOptions
+	if opts != nil {
+		o = *opts
 	}
+	o.From, o.To = from.String(), to.String()
+	return s.List(ctx, &o)
+}
 
-	return allExpenses, nil
+// ListUnbilledBillable returns the billable expenses spent from from to to
+// that haven't been invoiced yet. The API can only filter on is_billed, so
+// non-billable expenses are dropped after listing.
+func (s *ExpensesService) ListUnbilledBillable(ctx context.Context, from, to Date) ([]Expense, error) {
+	expenses, err := s.ListBetween(ctx, from, to, &ExpenseListOptions{IsBilled: Bool(false)})
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(expenses, func(e Expense) bool { return !e.Billable }), nil
 }
 
 // Get retrieves a specific expense.
-func (s *ExpensesService) Get(ctx context.Context, expenseID int64) (*Expense, error) {
+func (s *ExpensesService) Get(ctx context.Context, expenseID ExpenseID) (*Expense, error) {
 	return Get[Expense](ctx, s.client, fmt.Sprintf("expenses/%d", expenseID))
 }
 
 // ExpenseCreateRequest represents a request to create an expense.
 type ExpenseCreateRequest struct {
-	ProjectID         int64   `json:"project_id"`
-	ExpenseCategoryID int64   `json:"expense_category_id"`
-	SpentDate         string  `json:"spent_date"`
-	UserID            int64   `json:"user_id,omitempty"`
-	Notes             string  `json:"notes,omitempty"`
-	Units             float64 `json:"units,omitempty"`
-	TotalCost         float64 `json:"total_cost,omitempty"`
-	Billable          *bool   `json:"billable,omitempty"`
+	ProjectID         ProjectID         `json:"project_id"`
+	ExpenseCategoryID ExpenseCategoryID `json:"expense_category_id"`
+	SpentDate         string            `json:"spent_date"`
+	UserID            UserID            `json:"user_id,omitempty"`
+	Notes             string            `json:"notes,omitempty"`
+	Units             *decimal.Decimal  `json:"units,omitempty"`
+	TotalCost         *decimal.Decimal  `json:"total_cost,omitempty"`
+	Billable          *bool             `json:"billable,omitempty"`
+}
+
+// Validate checks that the required fields are set and that units and
+// total cost aren't both given.
+func (r *ExpenseCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ProjectID != 0, "project_id", "is required")
+	errs.require(r.ExpenseCategoryID != 0, "expense_category_id", "is required")
+	errs.require(r.SpentDate != "", "spent_date", "is required")
+	errs.date(r.SpentDate, "spent_date")
+	errs.require(r.Units == nil || r.TotalCost == nil, "total_cost", "must not be set with units")
+	errs.require(r.Units == nil || r.Units.IsPositive(), "units", "must be positive")
+	return errs.err()
 }
 
 // Create creates a new expense.
@@ -110,30 +167,95 @@ func (s *ExpensesService) Create(ctx context.Context, expense *ExpenseCreateRequ
 
 // ExpenseUpdateRequest represents a request to update an expense.
 type ExpenseUpdateRequest struct {
-	ProjectID         int64   `json:"project_id,omitempty"`
-	ExpenseCategoryID int64   `json:"expense_category_id,omitempty"`
-	SpentDate         string  `json:"spent_date,omitempty"`
-	Notes             string  `json:"notes,omitempty"`
-	Units             float64 `json:"units,omitempty"`
-	TotalCost         float64 `json:"total_cost,omitempty"`
-	Billable          *bool   `json:"billable,omitempty"`
+	ProjectID         ProjectID         `json:"project_id,omitempty"`
+	ExpenseCategoryID ExpenseCategoryID `json:"expense_category_id,omitempty"`
+	SpentDate         string            `json:"spent_date,omitempty"`
+	Notes             Nullable[string]  `json:"notes,omitzero"`
+	Units             *decimal.Decimal  `json:"units,omitempty"`
+	TotalCost         *decimal.Decimal  `json:"total_cost,omitempty"`
+	Billable          *bool             `json:"billable,omitempty"`
 }
 
 // Update updates an expense.
-func (s *ExpensesService) Update(ctx context.Context, expenseID int64, expense *ExpenseUpdateRequest) (*Expense, error) {
+func (s *ExpensesService) Update(ctx context.Context, expenseID ExpenseID, expense *ExpenseUpdateRequest) (*Expense, error) {
 	return Update[Expense](ctx, s.client, fmt.Sprintf("expenses/%d", expenseID), expense)
 }
 
 // Delete deletes an expense.
-func (s *ExpensesService) Delete(ctx context.Context, expenseID int64) error {
+func (s *ExpensesService) Delete(ctx context.Context, expenseID ExpenseID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("expenses/%d", expenseID))
 }
 
+// AttachReceipt uploads a receipt for an expense, replacing any existing
+// one. The content type is guessed from the filename's extension.
+func (s *ExpensesService) AttachReceipt(ctx context.Context, expenseID ExpenseID, filename string, r io.Reader) (*Expense, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	contentType := mime.TypeByExtension(filepath.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="receipt"; filename=%q`, filepath.Base(filename)))
+	h.Set("Content-Type", contentType)
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "PATCH", fmt.Sprintf("expenses/%d", expenseID), nil)
+	if err != nil {
+		return nil, err
+	}
+	data := body.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil }
+	req.ContentLength = int64(len(data))
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	var expense Expense
+	if _, err := s.client.Do(ctx, req, &expense); err != nil {
+		return nil, err
+	}
+	return &expense, nil
+}
+
+// CreateWithReceipt creates an expense and uploads its receipt. If the
+// upload fails, the expense is deleted again so no expense is left without
+// its receipt; the deletion runs even if ctx has been cancelled.
+func (s *ExpensesService) CreateWithReceipt(ctx context.Context, expense *ExpenseCreateRequest, filename string, receipt io.Reader) (*Expense, error) {
+	created, err := s.Create(ctx, expense)
+	if err != nil {
+		return nil, err
+	}
+	withReceipt, err := s.AttachReceipt(ctx, created.ID, filename, receipt)
+	if err != nil {
+		if delErr := s.Delete(context.WithoutCancel(ctx), created.ID); delErr != nil {
+			return nil, fmt.Errorf("harvest: attaching receipt: %w (deleting expense %d also failed: %v)", err, created.ID, delErr)
+		}
+		return nil, fmt.Errorf("harvest: attaching receipt: %w", err)
+	}
+	return withReceipt, nil
+}
+
+// DeleteReceipt removes the receipt from an expense.
+func (s *ExpensesService) DeleteReceipt(ctx context.Context, expenseID ExpenseID) (*Expense, error) {
+	req := struct {
+		DeleteReceipt bool `json:"delete_receipt"`
+	}{true}
+	return Update[Expense](ctx, s.client, fmt.Sprintf("expenses/%d", expenseID), req)
+}
+
 // ExpenseCategoryListOptions specifies optional parameters for listing expense categories.
 type ExpenseCategoryListOptions struct {
 	ListOptions
-	IsActive     *bool  `url:"is_active,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	IsActive *bool `url:"is_active,omitempty"`
 }
 
 // ExpenseCategoryList represents a list of expense categories.
@@ -142,32 +264,40 @@ type ExpenseCategoryList struct {
 	Paginated[ExpenseCategory]
 }
 
+func (l *ExpenseCategoryList) page() *Paginated[ExpenseCategory] {
+	l.ExpenseCategories = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both ExpenseCategories and Items.
+func (l *ExpenseCategoryList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "expense_categories", &l.Paginated, &l.ExpenseCategories)
+}
+
 // ListCategoriesPage returns a single page of expense categories.
 func (s *ExpensesService) ListCategoriesPage(ctx context.Context, opts *ExpenseCategoryListOptions) (*ExpenseCategoryList, error) {
-	u, err := addOptions("expense_categories", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[ExpenseCategory, ExpenseCategoryList](ctx, s.client, "expense_categories", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// ListCategories returns all expense categories across all pages.
+func (s *ExpensesService) ListCategories(ctx context.Context, opts *ExpenseCategoryListOptions) ([]ExpenseCategory, error) {
+	return collect(s.AllCategories(ctx, opts))
+}
 
-	var categories ExpenseCategoryList
-	_, err = s.client.Do(ctx, req, &categories)
-	if err != nil {
-		return nil, err
+// ListCategoriesWithTotals is like ListCategories but also returns the totals and rate
+// limit reported by the API.
+func (s *ExpensesService) ListCategoriesWithTotals(ctx context.Context, opts *ExpenseCategoryListOptions) (*ListResult[ExpenseCategory], error) {
+	if opts == nil {
+		opts = &ExpenseCategoryListOptions{}
 	}
-
-	// Copy categories to Items for pagination
-	categories.Items = categories.ExpenseCategories
-
-	return &categories, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[ExpenseCategory, error] {
+		return s.AllCategories(ctx, opts)
+	})
 }
 
-// ListCategories returns all expense categories across all pages.
-func (s *ExpensesService) ListCategories(ctx context.Context, opts *ExpenseCategoryListOptions) ([]ExpenseCategory, error) {
+// AllCategories returns an iterator over all expense categories, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *ExpensesService) AllCategories(ctx context.Context, opts *ExpenseCategoryListOptions) iter.Seq2[ExpenseCategory, error] {
 	if opts == nil {
 		opts = &ExpenseCategoryListOptions{}
 	}
@@ -178,37 +308,43 @@ func (s *ExpensesService) ListCategories(ctx context.Context, opts *ExpenseCateg
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allCategories []ExpenseCategory
-
-	for {
-		result, err := s.ListCategoriesPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allCategories = append(allCategories, result.ExpenseCategories...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[ExpenseCategory](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*ExpenseCategoryList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListCategoriesPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// StreamCategories sends all expense categories on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ExpensesService) StreamCategories(ctx context.Context, opts *ExpenseCategoryListOptions) (<-chan ExpenseCategory, <-chan error) {
+	if opts == nil {
+		opts = &ExpenseCategoryListOptions{}
 	}
-
-	return allCategories, nil
+	seq := s.AllCategories(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // GetCategory retrieves a specific expense category.
-func (s *ExpensesService) GetCategory(ctx context.Context, categoryID int64) (*ExpenseCategory, error) {
+func (s *ExpensesService) GetCategory(ctx context.Context, categoryID ExpenseCategoryID) (*ExpenseCategory, error) {
 	return Get[ExpenseCategory](ctx, s.client, fmt.Sprintf("expense_categories/%d", categoryID))
 }
 
 // ExpenseCategoryCreateRequest represents a request to create an expense category.
 type ExpenseCategoryCreateRequest struct {
-	Name      string  `json:"name"`
-	UnitName  string  `json:"unit_name,omitempty"`
-	UnitPrice float64 `json:"unit_price,omitempty"`
-	IsActive  *bool   `json:"is_active,omitempty"`
+	Name      string           `json:"name"`
+	UnitName  string           `json:"unit_name,omitempty"`
+	UnitPrice *decimal.Decimal `json:"unit_price,omitempty"`
+	IsActive  *bool            `json:"is_active,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *ExpenseCategoryCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Name != "", "name", "is required")
+	return errs.err()
 }
 
 // CreateCategory creates a new expense category.
@@ -218,18 +354,18 @@ func (s *ExpensesService) CreateCategory(ctx context.Context, category *ExpenseC
 
 // ExpenseCategoryUpdateRequest represents a request to update an expense category.
 type ExpenseCategoryUpdateRequest struct {
-	Name      string  `json:"name,omitempty"`
-	UnitName  string  `json:"unit_name,omitempty"`
-	UnitPrice float64 `json:"unit_price,omitempty"`
-	IsActive  *bool   `json:"is_active,omitempty"`
+	Name      string                    `json:"name,omitempty"`
+	UnitName  Nullable[string]          `json:"unit_name,omitzero"`
+	UnitPrice Nullable[decimal.Decimal] `json:"unit_price,omitzero"`
+	IsActive  *bool                     `json:"is_active,omitempty"`
 }
 
 // UpdateCategory updates an expense category.
-func (s *ExpensesService) UpdateCategory(ctx context.Context, categoryID int64, category *ExpenseCategoryUpdateRequest) (*ExpenseCategory, error) {
+func (s *ExpensesService) UpdateCategory(ctx context.Context, categoryID ExpenseCategoryID, category *ExpenseCategoryUpdateRequest) (*ExpenseCategory, error) {
 	return Update[ExpenseCategory](ctx, s.client, fmt.Sprintf("expense_categories/%d", categoryID), category)
 }
 
 // DeleteCategory deletes an expense category.
-func (s *ExpensesService) DeleteCategory(ctx context.Context, categoryID int64) error {
+func (s *ExpensesService) DeleteCategory(ctx context.Context, categoryID ExpenseCategoryID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("expense_categories/%d", categoryID))
 }
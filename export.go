@@ -0,0 +1,139 @@
+package harvest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ExportResources are the resources Export writes by default, in order.
+// Each name is also the API path it is read from.
+var ExportResources = []string{
+	"clients", "contacts", "projects", "user_assignments", "task_assignments",
+	"tasks", "users", "time_entries", "expenses", "invoices", "estimates",
+}
+
+// ExportCheckpoint records how far an export has got. It is passed to
+// ExportOptions.OnCheckpoint after every page and can be stored as JSON and
+// passed back in ExportOptions.Resume to continue an interrupted export.
+type ExportCheckpoint struct {
+	// Done lists the resources that have been written completely.
+	Done []string `json:"done,omitempty"`
+	// Resource is the resource in progress and NextPage or NextURL the
+	// page to read next.
+	Resource string `json:"resource,omitempty"`
+	NextPage int    `json:"next_page,omitempty"`
+	NextURL  string `json:"next_url,omitempty"`
+}
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	// Open returns the writer for a resource's JSON Lines. It is called
+	// once per resource before its first page. When resuming, it is called
+	// for the resource in progress too and should append to what was
+	// written before.
+	Open func(resource string) (io.Writer, error)
+	// Resources limits the export to these names from ExportResources. It
+	// defaults to all of them.
+	Resources []string
+	// Resume continues from a checkpoint saved by OnCheckpoint.
+	Resume *ExportCheckpoint
+	// OnCheckpoint is called after each page has been written. Returning
+	// an error stops the export.
+	OnCheckpoint func(ExportCheckpoint) error
+	// PerPage is the page size. It defaults to DefaultPerPage.
+	PerPage int
+}
+
+// Export writes every record of the selected resources to per-resource
+// writers as JSON Lines, one object per line exactly as the API returned
+// it. It is meant for compliance backups of a whole account. After a
+// failure, pass the last checkpoint to Resume to continue; at most the page
+// that was being written when the export stopped is written twice.
+//
+// Experimental: this API may change in minor releases.
+func (c *API) Export(ctx context.Context, opts ExportOptions) error {
+	resources := opts.Resources
+	if resources == nil {
+		resources = ExportResources
+	}
+	for _, name := range resources {
+		if !slices.Contains(ExportResources, name) {
+			return fmt.Errorf("harvest: unknown export resource %q", name)
+		}
+	}
+	perPage := opts.PerPage
+	if perPage == 0 {
+		perPage = DefaultPerPage
+	}
+
+	var cp ExportCheckpoint
+	if opts.Resume != nil {
+		cp = *opts.Resume
+		cp.Done = slices.Clone(cp.Done)
+	}
+	for _, name := range resources {
+		if slices.Contains(cp.Done, name) {
+			continue
+		}
+		if cp.Resource != name {
+			cp.Resource, cp.NextPage, cp.NextURL = name, 1, ""
+		}
+		w, err := opts.Open(name)
+		if err != nil {
+			return err
+		}
+		if err := c.exportResource(ctx, w, &cp, perPage, opts.OnCheckpoint); err != nil {
+			return fmt.Errorf("harvest: exporting %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// exportResource writes the pages of cp.Resource from the checkpointed
+// position, updating cp after each one.
+func (c *API) exportResource(ctx context.Context, w io.Writer, cp *ExportCheckpoint, perPage int, onCheckpoint func(ExportCheckpoint) error) error {
+	for {
+		page, err := fetchRateLimited(ctx, c, func(ctx context.Context) (*Paginated[json.RawMessage], error) {
+			if cp.NextURL != "" {
+				return getFromURL[Paginated[json.RawMessage]](ctx, c, cp.NextURL)
+			}
+			return listPage[json.RawMessage, Paginated[json.RawMessage]](ctx, c, cp.Resource, &ListOptions{Page: cp.NextPage, PerPage: perPage})
+		})
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		for _, item := range page.Items {
+			if err := json.Compact(&buf, item); err != nil {
+				return err
+			}
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+
+		switch {
+		case page.GetNextPageURL() != "":
+			cp.NextURL = page.GetNextPageURL()
+		case page.NextPage != nil:
+			cp.NextPage, cp.NextURL = *page.NextPage, ""
+		default:
+			cp.Done = append(cp.Done, cp.Resource)
+			cp.Resource, cp.NextPage, cp.NextURL = "", 0, ""
+		}
+		if onCheckpoint != nil {
+			if err := onCheckpoint(*cp); err != nil {
+				return err
+			}
+		}
+		if cp.Resource == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package harvest
+
+import "context"
+
+// ListByExternalReference returns the time entries linked to the item id in
+// another service, such as a Jira worklog. Harvest filters by id only, so
+// service and groupID, when not empty, narrow the result to references
+// with that service and group, which keeps ids that are only unique within
+// one service or project from matching each other.
+func (s *TimeEntriesService) ListByExternalReference(ctx context.Context, service, groupID, id string) ([]TimeEntry, error) {
+	entries, err := s.List(ctx, &TimeEntryListOptions{ExternalReferenceID: id})
+	if err != nil {
+		return nil, err
+	}
+	var matched []TimeEntry
+	for _, e := range entries {
+		ref := e.ExternalReference
+		if ref == nil || ref.ID != id {
+			continue
+		}
+		if (service != "" && ref.Service != service) || (groupID != "" && ref.GroupID != groupID) {
+			continue
+		}
+		matched = append(matched, e)
+	}
+	return matched, nil
+}
+
+// ExternalReferenceKey identifies an item in another service.
+type ExternalReferenceKey struct {
+	Service string
+	GroupID string
+	ID      string
+}
+
+// ExternalReferenceIndex maps items in other services to the time entries
+// linked to them, for integrations that sync in both directions. Build it
+// with IndexExternalReferences from a listing of the entries in scope.
+//
+// Experimental: this API may change in minor releases.
+type ExternalReferenceIndex struct {
+	byKey   map[ExternalReferenceKey][]TimeEntry
+	byGroup map[ExternalReferenceKey][]TimeEntry
+}
+
+// IndexExternalReferences indexes the entries that have an external
+// reference. Entries without one are skipped.
+func IndexExternalReferences(entries []TimeEntry) *ExternalReferenceIndex {
+	x := &ExternalReferenceIndex{
+		byKey:   make(map[ExternalReferenceKey][]TimeEntry),
+		byGroup: make(map[ExternalReferenceKey][]TimeEntry),
+	}
+	for _, e := range entries {
+		ref := e.ExternalReference
+		if ref == nil {
+			continue
+		}
+		key := ExternalReferenceKey{Service: ref.Service, GroupID: ref.GroupID, ID: ref.ID}
+		x.byKey[key] = append(x.byKey[key], e)
+		group := ExternalReferenceKey{Service: ref.Service, GroupID: ref.GroupID}
+		x.byGroup[group] = append(x.byGroup[group], e)
+	}
+	return x
+}
+
+// Lookup returns the entries linked to the item key.
+func (x *ExternalReferenceIndex) Lookup(key ExternalReferenceKey) []TimeEntry {
+	return x.byKey[key]
+}
+
+// Group returns the entries linked to any item in a service's group, such
+// as every worklog on one Jira issue.
+func (x *ExternalReferenceIndex) Group(service, groupID string) []TimeEntry {
+	return x.byGroup[ExternalReferenceKey{Service: service, GroupID: groupID}]
+}
+
+// Keys returns the keys of all indexed items, in no particular order.
+func (x *ExternalReferenceIndex) Keys() []ExternalReferenceKey {
+	keys := make([]ExternalReferenceKey, 0, len(x.byKey))
+	for key := range x.byKey {
+		keys = append(keys, key)
+	}
+	return keys
+}
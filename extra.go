@@ -0,0 +1,183 @@
+package harvest
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// extraType is the type of the Extra field on resources that keep response
+// fields the struct doesn't model.
+var extraType = reflect.TypeFor[map[string]json.RawMessage]()
+
+// decodeExtra decodes the JSON in r into v and then fills the Extra field of
+// every resource reachable from v. It is the decode path used by clients
+// created with WithExtraFields; other clients decode responses directly.
+func decodeExtra(r io.Reader, v any) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	captureExtra(data, reflect.ValueOf(v))
+	return nil
+}
+
+// captureExtra walks v alongside data, the JSON it was decoded from, setting
+// each Extra field it reaches to the fields its struct doesn't model.
+func captureExtra(data []byte, v reflect.Value) {
+	if !hasExtra(v.Type()) {
+		return
+	}
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return
+		}
+		for i := range min(len(items), v.Len()) {
+			captureExtra(items[i], v.Index(i))
+		}
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return
+		}
+		captureStructExtra(data, fields, v, false)
+	}
+}
+
+// captureStructExtra fills the Extra fields of the struct v, decoded from the
+// JSON object data with the given fields, and of the values nested in it.
+// embedded is set when v is a struct embedded in another.
+func captureStructExtra(data []byte, fields map[string]json.RawMessage, v reflect.Value, embedded bool) {
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		fv := v.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch {
+		case f.Name == "Extra" && f.Type == extraType:
+			if fv.CanSet() {
+				fv.Set(reflect.ValueOf(extraFields(fields, t)))
+			}
+		case f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct:
+			captureStructExtra(data, fields, fv, true)
+		case !f.IsExported():
+		case name == "-":
+			// A bare Paginated decodes Items from the first array field. The
+			// list types embedding it share Items with their named field,
+			// which is walked by its key instead.
+			if f.Name == "Items" && !embedded && strings.HasPrefix(t.Name(), "Paginated[") {
+				if raw, err := pageItems(data); err == nil && raw != nil {
+					captureExtra(raw, fv)
+				}
+			}
+		default:
+			if name == "" {
+				name = f.Name
+			}
+			if raw, ok := fields[name]; ok {
+				captureExtra(raw, fv)
+			}
+		}
+	}
+}
+
+// extraFields returns the entries of fields that have no matching field in
+// the struct type t. It returns nil when every field is modeled.
+func extraFields(fields map[string]json.RawMessage, t reflect.Type) map[string]json.RawMessage {
+	known := jsonKeys(t)
+	var extra map[string]json.RawMessage
+	for name, raw := range fields {
+		if known[name] {
+			continue
+		}
+		if extra == nil {
+			extra = map[string]json.RawMessage{}
+		}
+		extra[name] = raw
+	}
+	return extra
+}
+
+// extraTypes caches whether values of each type can hold an Extra field.
+var extraTypes sync.Map // map[reflect.Type]bool
+
+// hasExtra reports whether a value of type t has an Extra field somewhere
+// within it, so that captureExtra can skip everything else.
+func hasExtra(t reflect.Type) bool {
+	if has, ok := extraTypes.Load(t); ok {
+		return has.(bool)
+	}
+	has := typeHasExtra(t, map[reflect.Type]bool{})
+	extraTypes.Store(t, has)
+	return has
+}
+
+func typeHasExtra(t reflect.Type, seen map[reflect.Type]bool) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return typeHasExtra(t.Elem(), seen)
+	case reflect.Struct:
+		if seen[t] {
+			return false
+		}
+		seen[t] = true
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if f.Name == "Extra" && f.Type == extraType || typeHasExtra(f.Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// knownFields caches the JSON keys modeled by each struct type.
+var knownFields sync.Map // map[reflect.Type]map[string]bool
+
+// jsonKeys returns the JSON keys encoding/json maps to fields of t,
+// including those promoted from embedded structs.
+func jsonKeys(t reflect.Type) map[string]bool {
+	if keys, ok := knownFields.Load(t); ok {
+		return keys.(map[string]bool)
+	}
+
+	keys := map[string]bool{}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for k := range jsonKeys(f.Type) {
+				keys[k] = true
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		keys[name] = true
+	}
+
+	knownFields.Store(t, keys)
+	return keys
+}
@@ -0,0 +1,75 @@
+package harvest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const timeEntriesPage = `{
+  "time_entries": [
+    {
+      "id": 636709355,
+      "hours": 2.5,
+      "is_billed": false,
+      "external_status": "synced",
+      "user": {"id": 1782959, "name": "Kim Allen", "pronouns": "they/them"},
+      "project": {"id": 14307913, "name": "Marketing Website", "color": "#ff0000"}
+    }
+  ],
+  "per_page": 2000,
+  "total_pages": 1,
+  "total_entries": 1,
+  "next_page": null,
+  "previous_page": null,
+  "page": 1,
+  "links": {"first": "", "last": ""}
+}`
+
+func serveTimeEntries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	io.WriteString(w, timeEntriesPage)
+}
+
+func TestExtraFieldsOffByDefault(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(serveTimeEntries))
+	entries, err := c.TimeEntries.List(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	e := entries[0]
+	if e.Extra != nil || e.User.Extra != nil || e.Project.Extra != nil {
+		t.Errorf("Extra captured without WithExtraFields: %v %v %v", e.Extra, e.User.Extra, e.Project.Extra)
+	}
+	if e.User.Name != "Kim Allen" {
+		t.Errorf("User.Name = %q, want %q", e.User.Name, "Kim Allen")
+	}
+}
+
+func TestWithExtraFields(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(serveTimeEntries), WithExtraFields())
+	entries, err := c.TimeEntries.List(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	e := entries[0]
+	if got := string(e.Extra["external_status"]); got != `"synced"` {
+		t.Errorf("Extra[external_status] = %q", got)
+	}
+	if _, ok := e.Extra["is_billed"]; ok {
+		t.Error("modeled field is_billed captured in Extra")
+	}
+	if got := string(e.User.Extra["pronouns"]); got != `"they/them"` {
+		t.Errorf("User.Extra[pronouns] = %q", got)
+	}
+	if got := string(e.Project.Extra["color"]); got != `"#ff0000"` {
+		t.Errorf("Project.Extra[color] = %q", got)
+	}
+}
@@ -0,0 +1,42 @@
+// Package fixtures provides recorded Harvest report responses covering cases
+// that dashboards commonly get wrong. Decode them into the matching harvest
+// result types and feed the rows through helpers such as
+// harvest.AlignTimeReports to golden-test report handling without API calls.
+package fixtures
+
+import (
+	"embed"
+	"encoding/json"
+)
+
+// Fixture names accepted by Load.
+const (
+	// TimeReportMixedCurrencies is a time report page whose rows are billed
+	// in more than one currency, so billable amounts must not be summed blindly.
+	TimeReportMixedCurrencies = "time_report_mixed_currencies.json"
+	// TimeReportContractors is a team time report page mixing employees and
+	// contractors, including a contractor with no weekly capacity.
+	TimeReportContractors = "time_report_contractors.json"
+	// ExpenseReportMixedCurrencies is an expense report page spanning currencies.
+	ExpenseReportMixedCurrencies = "expense_report_mixed_currencies.json"
+	// ProjectBudgetMonthly is a project budget report page mixing monthly and
+	// lifetime budgets, an over-budget project, and a project with no budget.
+	ProjectBudgetMonthly = "project_budget_monthly.json"
+)
+
+//go:embed *.json
+var files embed.FS
+
+// Load decodes the named fixture into v.
+func Load(name string, v any) error {
+	data, err := files.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Raw returns the named fixture's JSON as recorded.
+func Raw(name string) ([]byte, error) {
+	return files.ReadFile(name)
+}
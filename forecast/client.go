@@ -0,0 +1,70 @@
+// Package forecast is a client for the Harvest Forecast API, which holds
+// the people, projects and scheduled assignments used for capacity
+// planning.
+//
+// Forecast shares authentication with Harvest, so a Client sends its
+// requests through a *harvest.API and inherits its token, User-Agent,
+// timeouts, debug output and usage tracking. Forecast accounts have their
+// own account ID, shown in the Forecast URL.
+//
+// Experimental: this package may change in minor releases.
+package forecast
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+	"github.com/joefitzgerald/harvest"
+)
+
+const defaultBaseURL = "https://api.forecastapp.com/"
+
+// Client is a Forecast API client.
+type Client struct {
+	api       *harvest.API
+	accountID string
+	baseURL   string
+
+	// Service endpoints
+	People      *PeopleService
+	Projects    *ProjectsService
+	Assignments *AssignmentsService
+	Milestones  *MilestonesService
+}
+
+// New returns a Forecast client for accountID that sends its requests
+// through api.
+func New(api *harvest.API, accountID string) *Client {
+	c := &Client{api: api, accountID: accountID, baseURL: defaultBaseURL}
+	c.People = &PeopleService{client: c}
+	c.Projects = &ProjectsService{client: c}
+	c.Assignments = &AssignmentsService{client: c}
+	c.Milestones = &MilestonesService{client: c}
+	return c
+}
+
+// get fetches path with the query parameters in opts and decodes the
+// response into v.
+func (c *Client) get(ctx context.Context, path string, opts any, v any) error {
+	u := c.baseURL + path
+	if opts != nil {
+		qs, err := query.Values(opts)
+		if err != nil {
+			return err
+		}
+		if len(qs) > 0 {
+			u += "?" + qs.Encode()
+		}
+	}
+
+	req, err := c.api.NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Del("Harvest-Account-Id")
+	req.Header.Set("Forecast-Account-Id", c.accountID)
+
+	_, err = c.api.Do(ctx, req, v)
+	return err
+}
@@ -0,0 +1,111 @@
+package forecast
+
+import (
+	"context"
+	"fmt"
+)
+
+// PeopleService handles the people endpoints.
+type PeopleService struct {
+	client *Client
+}
+
+// List returns all people on the account.
+func (s *PeopleService) List(ctx context.Context) ([]Person, error) {
+	var result struct {
+		People []Person `json:"people"`
+	}
+	if err := s.client.get(ctx, "people", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.People, nil
+}
+
+// Get retrieves a person.
+func (s *PeopleService) Get(ctx context.Context, id PersonID) (*Person, error) {
+	var result struct {
+		Person Person `json:"person"`
+	}
+	if err := s.client.get(ctx, fmt.Sprintf("people/%d", id), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result.Person, nil
+}
+
+// ProjectsService handles the project endpoints.
+type ProjectsService struct {
+	client *Client
+}
+
+// List returns all projects on the account.
+func (s *ProjectsService) List(ctx context.Context) ([]Project, error) {
+	var result struct {
+		Projects []Project `json:"projects"`
+	}
+	if err := s.client.get(ctx, "projects", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Projects, nil
+}
+
+// Get retrieves a project.
+func (s *ProjectsService) Get(ctx context.Context, id ProjectID) (*Project, error) {
+	var result struct {
+		Project Project `json:"project"`
+	}
+	if err := s.client.get(ctx, fmt.Sprintf("projects/%d", id), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result.Project, nil
+}
+
+// AssignmentsService handles the assignment endpoints.
+type AssignmentsService struct {
+	client *Client
+}
+
+// AssignmentListOptions specifies optional parameters to the List method.
+// StartDate and EndDate return the assignments overlapping that range.
+type AssignmentListOptions struct {
+	StartDate     string        `url:"start_date,omitempty"`
+	EndDate       string        `url:"end_date,omitempty"`
+	PersonID      PersonID      `url:"person_id,omitempty"`
+	ProjectID     ProjectID     `url:"project_id,omitempty"`
+	PlaceholderID PlaceholderID `url:"placeholder_id,omitempty"`
+	// State is "active" or "archived".
+	State string `url:"state,omitempty"`
+}
+
+// List returns the assignments matching opts.
+func (s *AssignmentsService) List(ctx context.Context, opts *AssignmentListOptions) ([]Assignment, error) {
+	var result struct {
+		Assignments []Assignment `json:"assignments"`
+	}
+	if err := s.client.get(ctx, "assignments", opts, &result); err != nil {
+		return nil, err
+	}
+	return result.Assignments, nil
+}
+
+// MilestonesService handles the milestone endpoints.
+type MilestonesService struct {
+	client *Client
+}
+
+// MilestoneListOptions specifies optional parameters to the List method.
+type MilestoneListOptions struct {
+	StartDate string    `url:"start_date,omitempty"`
+	EndDate   string    `url:"end_date,omitempty"`
+	ProjectID ProjectID `url:"project_id,omitempty"`
+}
+
+// List returns the milestones matching opts.
+func (s *MilestonesService) List(ctx context.Context, opts *MilestoneListOptions) ([]Milestone, error) {
+	var result struct {
+		Milestones []Milestone `json:"milestones"`
+	}
+	if err := s.client.get(ctx, "milestones", opts, &result); err != nil {
+		return nil, err
+	}
+	return result.Milestones, nil
+}
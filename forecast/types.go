@@ -0,0 +1,97 @@
+package forecast
+
+import (
+	"time"
+
+	"github.com/joefitzgerald/harvest"
+)
+
+// PersonID identifies a Forecast person.
+type PersonID int64
+
+// ProjectID identifies a Forecast project.
+type ProjectID int64
+
+// AssignmentID identifies a Forecast assignment.
+type AssignmentID int64
+
+// MilestoneID identifies a Forecast milestone.
+type MilestoneID int64
+
+// PlaceholderID identifies a Forecast placeholder, a role scheduled before
+// a person is hired or chosen for it.
+type PlaceholderID int64
+
+// Person represents a person scheduled in Forecast.
+type Person struct {
+	ID        PersonID `json:"id"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+	Email     string   `json:"email"`
+	Admin     bool     `json:"admin"`
+	Archived  bool     `json:"archived"`
+	Roles     []string `json:"roles"`
+	AvatarURL string   `json:"avatar_url"`
+	// HarvestUserID links the person to their Harvest user, when they have
+	// one.
+	HarvestUserID *harvest.UserID `json:"harvest_user_id"`
+	// WeeklyCapacity is in seconds.
+	WeeklyCapacity int          `json:"weekly_capacity"`
+	WorkingDays    *WorkingDays `json:"working_days"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// WorkingDays records which days of the week a person works.
+type WorkingDays struct {
+	Monday    bool `json:"monday"`
+	Tuesday   bool `json:"tuesday"`
+	Wednesday bool `json:"wednesday"`
+	Thursday  bool `json:"thursday"`
+	Friday    bool `json:"friday"`
+	Saturday  bool `json:"saturday"`
+	Sunday    bool `json:"sunday"`
+}
+
+// Project represents a Forecast project.
+type Project struct {
+	ID        ProjectID    `json:"id"`
+	Name      string       `json:"name"`
+	Code      string       `json:"code"`
+	Color     string       `json:"color"`
+	Notes     string       `json:"notes"`
+	StartDate harvest.Date `json:"start_date"`
+	EndDate   harvest.Date `json:"end_date"`
+	Archived  bool         `json:"archived"`
+	Tags      []string     `json:"tags"`
+	ClientID  *int64       `json:"client_id"`
+	// HarvestID links the project to its Harvest project, when it has one.
+	HarvestID *harvest.ProjectID `json:"harvest_id"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// Assignment schedules a person or placeholder on a project for a date
+// range.
+type Assignment struct {
+	ID            AssignmentID   `json:"id"`
+	ProjectID     ProjectID      `json:"project_id"`
+	PersonID      *PersonID      `json:"person_id"`
+	PlaceholderID *PlaceholderID `json:"placeholder_id"`
+	StartDate     harvest.Date   `json:"start_date"`
+	EndDate       harvest.Date   `json:"end_date"`
+	// Allocation is the time scheduled per working day, in seconds. It is
+	// nil for assignments that take a person's whole day.
+	Allocation              *int      `json:"allocation"`
+	Notes                   string    `json:"notes"`
+	ActiveOnDaysOff         bool      `json:"active_on_days_off"`
+	RepeatedAssignmentSetID *int64    `json:"repeated_assignment_set_id"`
+	UpdatedAt               time.Time `json:"updated_at"`
+}
+
+// Milestone is a dated marker on a Forecast project.
+type Milestone struct {
+	ID        MilestoneID  `json:"id"`
+	ProjectID ProjectID    `json:"project_id"`
+	Name      string       `json:"name"`
+	Date      harvest.Date `json:"date"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
@@ -5,4 +5,5 @@ go 1.25.1
 require (
 	github.com/google/go-querystring v1.1.0
 	github.com/shopspring/decimal v1.4.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,220 @@
+package harvest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+// accountSettingsKind is the Snapshot kind used for AccountSettings.
+const accountSettingsKind = "account_settings"
+
+// AccountSettings captures the account configuration that affects billing:
+// company settings, roles, the task catalog and expense categories. Save an
+// approved copy with WriteSnapshot and compare live state against it with
+// DiffAccountSettings to catch out-of-band changes.
+//
+// Experimental: this API may change in minor releases.
+type AccountSettings struct {
+	CapturedAt        time.Time         `json:"captured_at"`
+	Company           *Company          `json:"company"`
+	Roles             []Role            `json:"roles"`
+	Tasks             []Task            `json:"tasks"`
+	ExpenseCategories []ExpenseCategory `json:"expense_categories"`
+}
+
+// CaptureAccountSettings fetches the current account settings.
+//
+// Experimental: this API may change in minor releases.
+func CaptureAccountSettings(ctx context.Context, c *API) (*AccountSettings, error) {
+	company, err := c.Company.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := c.Roles.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := c.Tasks.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := c.Expenses.ListCategories(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountSettings{
+		CapturedAt:        time.Now().UTC(),
+		Company:           company,
+		Roles:             roles,
+		Tasks:             tasks,
+		ExpenseCategories: categories,
+	}, nil
+}
+
+// WriteSnapshot writes the settings to w in the versioned snapshot format.
+func (s *AccountSettings) WriteSnapshot(w io.Writer) error {
+	return WriteSnapshot(w, accountSettingsKind, s)
+}
+
+// ReadAccountSettings reads settings written by AccountSettings.WriteSnapshot.
+// It fails if the snapshot was written with a different SnapshotVersion.
+//
+// Experimental: this API may change in minor releases.
+func ReadAccountSettings(r io.Reader) (*AccountSettings, error) {
+	var settings AccountSettings
+	if err := ReadSnapshot(r, accountSettingsKind, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SettingChangeType describes how a setting differs from the baseline.
+type SettingChangeType string
+
+const (
+	SettingAdded   SettingChangeType = "added"
+	SettingRemoved SettingChangeType = "removed"
+	SettingChanged SettingChangeType = "changed"
+)
+
+// SettingChange is a single difference between two AccountSettings.
+type SettingChange struct {
+	// Kind is "company", "role", "task" or "expense_category".
+	Kind string            `json:"kind"`
+	ID   int64             `json:"id,omitempty"`
+	Name string            `json:"name,omitempty"`
+	Type SettingChangeType `json:"type"`
+	// Field, Baseline and Current are set for SettingChanged and hold the
+	// JSON field name and values.
+	Field    string          `json:"field,omitempty"`
+	Baseline json.RawMessage `json:"baseline,omitempty"`
+	Current  json.RawMessage `json:"current,omitempty"`
+}
+
+func (c SettingChange) String() string {
+	subject := c.Kind
+	if c.ID != 0 {
+		subject = fmt.Sprintf("%s %d (%s)", c.Kind, c.ID, c.Name)
+	}
+	if c.Type == SettingChanged {
+		return fmt.Sprintf("%s: %s changed from %s to %s", subject, c.Field, c.Baseline, c.Current)
+	}
+	return fmt.Sprintf("%s: %s", subject, c.Type)
+}
+
+// DiffAccountSettings reports how current differs from an approved baseline.
+// Timestamps are ignored. An empty result means no drift.
+//
+// Experimental: this API may change in minor releases.
+func DiffAccountSettings(baseline, current *AccountSettings) []SettingChange {
+	var changes []SettingChange
+	for _, f := range diffFields(baseline.Company, current.Company) {
+		changes = append(changes, SettingChange{Kind: "company", Type: SettingChanged, Field: f.name, Baseline: f.baseline, Current: f.current})
+	}
+	changes = append(changes, diffRecords("role", baseline.Roles, current.Roles, func(r Role) (int64, string) { return int64(r.ID), r.Name })...)
+	changes = append(changes, diffRecords("task", baseline.Tasks, current.Tasks, func(t Task) (int64, string) { return int64(t.ID), t.Name })...)
+	changes = append(changes, diffRecords("expense_category", baseline.ExpenseCategories, current.ExpenseCategories, func(e ExpenseCategory) (int64, string) { return int64(e.ID), e.Name })...)
+	return changes
+}
+
+// diffRecords matches records by ID and reports additions, removals and
+// changed fields, ordered by ID.
+func diffRecords[T any](kind string, baseline, current []T, key func(T) (int64, string)) []SettingChange {
+	before := make(map[int64]T, len(baseline))
+	for _, r := range baseline {
+		id, _ := key(r)
+		before[id] = r
+	}
+	after := make(map[int64]T, len(current))
+	for _, r := range current {
+		id, _ := key(r)
+		after[id] = r
+	}
+
+	var ids []int64
+	for id := range before {
+		ids = append(ids, id)
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	var changes []SettingChange
+	for _, id := range ids {
+		b, inBefore := before[id]
+		a, inAfter := after[id]
+		switch {
+		case !inAfter:
+			_, name := key(b)
+			changes = append(changes, SettingChange{Kind: kind, ID: id, Name: name, Type: SettingRemoved})
+		case !inBefore:
+			_, name := key(a)
+			changes = append(changes, SettingChange{Kind: kind, ID: id, Name: name, Type: SettingAdded})
+		default:
+			_, name := key(a)
+			for _, f := range diffFields(b, a) {
+				changes = append(changes, SettingChange{Kind: kind, ID: id, Name: name, Type: SettingChanged, Field: f.name, Baseline: f.baseline, Current: f.current})
+			}
+		}
+	}
+	return changes
+}
+
+type fieldDiff struct {
+	name              string
+	baseline, current json.RawMessage
+}
+
+// diffFields compares the JSON encodings of a and b field by field,
+// skipping timestamps.
+func diffFields(a, b any) []fieldDiff {
+	before, after := jsonFields(a), jsonFields(b)
+
+	var names []string
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var diffs []fieldDiff
+	for _, name := range names {
+		if name == "created_at" || name == "updated_at" {
+			continue
+		}
+		if !bytes.Equal(before[name], after[name]) {
+			diffs = append(diffs, fieldDiff{name: name, baseline: before[name], current: after[name]})
+		}
+	}
+	return diffs
+}
+
+// jsonFields returns the compacted JSON value of each top-level field of v.
+func jsonFields(v any) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	for name, raw := range fields {
+		var buf bytes.Buffer
+		if json.Compact(&buf, raw) == nil {
+			fields[name] = buf.Bytes()
+		}
+	}
+	return fields
+}
@@ -0,0 +1,25 @@
+package harvest
+
+// Typed IDs for Harvest resources. They are distinct types so that passing,
+// say, a user ID where a project ID is expected fails to compile. Convert
+// from a plain integer with e.g. harvest.ProjectID(123).
+type (
+	ClientID               int64
+	ContactID              int64
+	ProjectID              int64
+	TaskID                 int64
+	UserID                 int64
+	RoleID                 int64
+	UserAssignmentID       int64
+	TaskAssignmentID       int64
+	TimeEntryID            int64
+	ExpenseID              int64
+	ExpenseCategoryID      int64
+	InvoiceID              int64
+	InvoiceMessageID       int64
+	InvoicePaymentID       int64
+	InvoiceItemCategoryID  int64
+	EstimateID             int64
+	EstimateMessageID      int64
+	EstimateItemCategoryID int64
+)
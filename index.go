@@ -0,0 +1,235 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrAmbiguousName is returned by Index lookups when a name matches more
+// than one record.
+var ErrAmbiguousName = errors.New("harvest: name matches more than one record")
+
+// Index finds projects, clients and tasks by the human identifiers
+// integrations usually receive, such as a project code, instead of their
+// IDs. Each resource is listed once, on first use, and kept in memory
+// until it is older than the index's max age or Refresh is called. Names
+// and codes are matched case-insensitively. An Index is safe for
+// concurrent use.
+//
+// Experimental: this API may change in minor releases.
+type Index struct {
+	client *API
+
+	projects indexed[Project]
+	clients  indexed[Client]
+	tasks    indexed[Task]
+}
+
+// indexed is one cached resource listing. Each listing has its own lock so
+// that loading one resource doesn't block look-ups of the others.
+type indexed[T any] struct {
+	mu       sync.Mutex
+	maxAge   time.Duration
+	store    listingStore[T]
+	items    []T
+	loadedAt time.Time
+	inflight *indexLoad[T]
+	// refreshed is set by reset so that the next load lists the items
+	// rather than seeding them from the store.
+	refreshed bool
+}
+
+// indexLoad is a listing in progress, shared by every look-up waiting on it.
+type indexLoad[T any] struct {
+	done  chan struct{}
+	items []T
+	err   error
+}
+
+// reset discards the cached listing. A listing already in progress is not
+// stored when it completes.
+func (c *indexed[T]) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items, c.loadedAt, c.inflight, c.refreshed = nil, time.Time{}, nil, true
+}
+
+// stale reports whether a listing loaded at loadedAt must be loaded again.
+func stale(loadedAt time.Time, maxAge time.Duration) bool {
+	return loadedAt.IsZero() || (maxAge > 0 && time.Since(loadedAt) > maxAge)
+}
+
+// NewIndex returns an Index that lists resources through client. A zero
+// maxAge keeps listings until Refresh is called.
+func NewIndex(client *API, maxAge time.Duration) *Index {
+	x := &Index{client: client}
+	x.projects.maxAge, x.clients.maxAge, x.tasks.maxAge = maxAge, maxAge, maxAge
+	return x
+}
+
+// Refresh discards the cached listings so the next lookups reload them
+// from Harvest, bypassing any stores the index persists to.
+func (x *Index) Refresh() {
+	x.projects.reset()
+	x.clients.reset()
+	x.tasks.reset()
+}
+
+// FindProjectByCode returns the project with the given code.
+func (x *Index) FindProjectByCode(ctx context.Context, code string) (*Project, error) {
+	return findIndexed(ctx, x, &x.projects, x.client.Projects.List, "project code", code, func(p Project) string { return p.Code })
+}
+
+// FindProjectByName returns the project with the given name.
+func (x *Index) FindProjectByName(ctx context.Context, name string) (*Project, error) {
+	return findIndexed(ctx, x, &x.projects, x.client.Projects.List, "project name", name, func(p Project) string { return p.Name })
+}
+
+// FindClientByName returns the client with the given name.
+func (x *Index) FindClientByName(ctx context.Context, name string) (*Client, error) {
+	return findIndexed(ctx, x, &x.clients, x.client.Clients.List, "client name", name, func(c Client) string { return c.Name })
+}
+
+// FindTaskByName returns the task with the given name.
+func (x *Index) FindTaskByName(ctx context.Context, name string) (*Task, error) {
+	return findIndexed(ctx, x, &x.tasks, x.client.Tasks.List, "task name", name, func(t Task) string { return t.Name })
+}
+
+// ProjectFilter selects projects in Index.Projects.
+type ProjectFilter func(*Project) bool
+
+// ActiveProjects selects active projects.
+func ActiveProjects() ProjectFilter {
+	return func(p *Project) bool { return p.IsActive }
+}
+
+// ProjectsForClient selects the projects of a client.
+func ProjectsForClient(clientID ClientID) ProjectFilter {
+	return func(p *Project) bool { return p.Client != nil && p.Client.ID == clientID }
+}
+
+// ProjectsWithCodePrefix selects projects whose code starts with prefix,
+// ignoring case.
+func ProjectsWithCodePrefix(prefix string) ProjectFilter {
+	return func(p *Project) bool {
+		return len(p.Code) >= len(prefix) && strings.EqualFold(p.Code[:len(prefix)], prefix)
+	}
+}
+
+// Projects returns the cached projects matching all filters, in the order
+// Harvest lists them, or by ID when the listing was seeded from a store.
+func (x *Index) Projects(ctx context.Context, filters ...ProjectFilter) ([]Project, error) {
+	items, err := loadIndexed(ctx, x.client, &x.projects, x.client.Projects.List)
+	if err != nil {
+		return nil, err
+	}
+	var matched []Project
+	for i := range items {
+		if slices.ContainsFunc(filters, func(f ProjectFilter) bool { return !f(&items[i]) }) {
+			continue
+		}
+		matched = append(matched, items[i])
+	}
+	return matched, nil
+}
+
+// loadIndexed returns the items in cache, loading them with list if the
+// cache is empty or stale. Concurrent callers share a single load, which
+// runs without holding the cache's lock.
+func loadIndexed[T any, O any](ctx context.Context, client *API, cache *indexed[T], list func(context.Context, *O) ([]T, error)) ([]T, error) {
+	cache.mu.Lock()
+	if !stale(cache.loadedAt, cache.maxAge) {
+		items := cache.items
+		cache.mu.Unlock()
+		return items, nil
+	}
+
+	load := cache.inflight
+	if load == nil {
+		load = &indexLoad[T]{done: make(chan struct{})}
+		cache.inflight = load
+		store, maxAge, seed := cache.store, cache.maxAge, !cache.refreshed
+		// The load outlives the caller that started it so that one
+		// cancelled look-up doesn't fail the others waiting on it.
+		go func() {
+			items, loadedAt, err := fetchListing(context.WithoutCancel(ctx), client, store, maxAge, seed, list)
+
+			cache.mu.Lock()
+			if cache.inflight == load {
+				if err == nil {
+					cache.items, cache.loadedAt, cache.refreshed = items, loadedAt, false
+				}
+				cache.inflight = nil
+			}
+			cache.mu.Unlock()
+
+			load.items, load.err = items, err
+			close(load.done)
+		}()
+	}
+	cache.mu.Unlock()
+
+	select {
+	case <-load.done:
+		return load.items, load.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetchListing returns the listing held by store when seed is set and it
+// isn't stale, and otherwise lists the items and saves them to store.
+// Store failures are logged rather than failing the look-up.
+func fetchListing[T any, O any](ctx context.Context, client *API, store listingStore[T], maxAge time.Duration, seed bool, list func(context.Context, *O) ([]T, error)) ([]T, time.Time, error) {
+	if store != nil && seed {
+		items, loadedAt, err := store.load(ctx)
+		if err != nil {
+			client.warnStore("load", err)
+		} else if !stale(loadedAt, maxAge) {
+			return items, loadedAt, nil
+		}
+	}
+
+	items, err := list(ctx, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	loadedAt := time.Now()
+	if store != nil {
+		if err := store.save(ctx, items, loadedAt); err != nil {
+			client.warnStore("save", err)
+		}
+	}
+	return items, loadedAt, nil
+}
+
+// findIndexed loads cache with list if it is empty or stale and returns the
+// single item whose key matches value. It wraps ErrNotFound when nothing
+// matches and ErrAmbiguousName when several items do.
+func findIndexed[T any, O any](ctx context.Context, x *Index, cache *indexed[T], list func(context.Context, *O) ([]T, error), what, value string, key func(T) string) (*T, error) {
+	items, err := loadIndexed(ctx, x.client, cache, list)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *T
+	for i := range items {
+		if value == "" || !strings.EqualFold(key(items[i]), value) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%w: %s %q", ErrAmbiguousName, what, value)
+		}
+		item := items[i]
+		found = &item
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s %q", ErrNotFound, what, value)
+	}
+	return found, nil
+}
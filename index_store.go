@@ -0,0 +1,106 @@
+package harvest
+
+import (
+	"context"
+	"time"
+)
+
+// listingStore persists one cached resource listing with the time it was
+// loaded.
+type listingStore[T any] interface {
+	// load returns the stored listing and when it was loaded, or a zero
+	// time if the store holds no complete listing.
+	load(ctx context.Context) ([]T, time.Time, error)
+	// save replaces the stored listing with items, loaded at loadedAt.
+	save(ctx context.Context, items []T, loadedAt time.Time) error
+}
+
+// stampedListing adapts a StampedStore to listingStore.
+type stampedListing[K ~int64, T any] struct {
+	store StampedStore[K, T]
+	key   func(T) K
+}
+
+// storeListing returns a listingStore backed by store, or nil if store is nil.
+func storeListing[K ~int64, T any](store StampedStore[K, T], key func(T) K) listingStore[T] {
+	if store == nil {
+		return nil
+	}
+	return stampedListing[K, T]{store: store, key: key}
+}
+
+func (s stampedListing[K, T]) load(ctx context.Context) ([]T, time.Time, error) {
+	loadedAt, err := s.store.LoadedAt(ctx)
+	if err != nil || loadedAt.IsZero() {
+		return nil, time.Time{}, err
+	}
+	items, err := s.store.Query(ctx, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return items, loadedAt, nil
+}
+
+// save clears the store's timestamp first so that a listing left half
+// written by a failed save is never mistaken for a complete one.
+func (s stampedListing[K, T]) save(ctx context.Context, items []T, loadedAt time.Time) error {
+	if err := s.store.SetLoadedAt(ctx, time.Time{}); err != nil {
+		return err
+	}
+
+	keep := make(map[K]bool, len(items))
+	for _, item := range items {
+		keep[s.key(item)] = true
+	}
+	stored, err := s.store.Query(ctx, func(item T) bool { return !keep[s.key(item)] })
+	if err != nil {
+		return err
+	}
+	removed := make([]K, len(stored))
+	for i, item := range stored {
+		removed[i] = s.key(item)
+	}
+	if len(removed) > 0 {
+		if err := s.store.Delete(ctx, removed...); err != nil {
+			return err
+		}
+	}
+	if len(items) > 0 {
+		if err := s.store.Upsert(ctx, items...); err != nil {
+			return err
+		}
+	}
+	return s.store.SetLoadedAt(ctx, loadedAt)
+}
+
+// warnStore logs that a cached listing could not be loaded from or saved to
+// its store.
+func (c *API) warnStore(op string, err error) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.Warn("harvest: cached listing store failed", "op", op, "error", err)
+}
+
+// IndexStores are the stores an Index persists its listings to. A nil
+// store leaves that listing in memory only.
+type IndexStores struct {
+	Projects StampedStore[ProjectID, Project]
+	Clients  StampedStore[ClientID, Client]
+	Tasks    StampedStore[TaskID, Task]
+}
+
+// NewPersistentIndex returns an Index like NewIndex that also saves each
+// listing it fetches to the matching store in stores, and seeds an empty
+// listing from its store when the stored listing is no older than maxAge.
+// Short-lived programs sharing a store, such as a SQLiteStore, can then
+// skip the listings on most runs.
+//
+// Experimental: this API may change in minor releases.
+func NewPersistentIndex(client *API, maxAge time.Duration, stores IndexStores) *Index {
+	x := NewIndex(client, maxAge)
+	x.projects.store = storeListing(stores.Projects, func(p Project) ProjectID { return p.ID })
+	x.clients.store = storeListing(stores.Clients, func(c Client) ClientID { return c.ID })
+	x.tasks.store = storeListing(stores.Tasks, func(t Task) TaskID { return t.ID })
+	return x
+}
@@ -0,0 +1,123 @@
+package harvest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingProjects serves one page of projects, counting the listings.
+func countingProjects(requests *atomic.Int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		io.WriteString(w, projectsPage)
+	})
+}
+
+func newProjectStore() *MemoryStore[ProjectID, Project] {
+	return NewMemoryStore(func(p Project) ProjectID { return p.ID })
+}
+
+func TestPersistentIndexSeedsFromStore(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	client := newTestClient(t, countingProjects(&requests))
+	store := newProjectStore()
+
+	// A project deleted since the last run must not survive the save.
+	if err := store.Upsert(ctx, Project{ID: 1, Code: "OLD"}); err != nil {
+		t.Fatal(err)
+	}
+
+	first := NewPersistentIndex(client, time.Hour, IndexStores{Projects: store})
+	if _, err := first.FindProjectByCode(ctx, "MW"); err != nil {
+		t.Fatal(err)
+	}
+	loadedAt, err := store.LoadedAt(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loadedAt.IsZero() {
+		t.Fatal("listing was not saved to the store")
+	}
+	stored, err := store.Query(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || stored[0].ID != 14307913 {
+		t.Errorf("stored projects = %+v, want only the listed project", stored)
+	}
+
+	// A later run seeds its index from the store without listing.
+	second := NewPersistentIndex(client, time.Hour, IndexStores{Projects: store})
+	if _, err := second.FindProjectByCode(ctx, "MW"); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("listed projects %d times, want 1", n)
+	}
+
+	// Refresh bypasses the store.
+	second.Refresh()
+	if _, err := second.FindProjectByCode(ctx, "MW"); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("listed projects %d times after Refresh, want 2", n)
+	}
+}
+
+func TestPersistentIndexStaleStore(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	client := newTestClient(t, countingProjects(&requests))
+	store := newProjectStore()
+	if err := store.Upsert(ctx, Project{ID: 14307913, Code: "MW"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetLoadedAt(ctx, time.Now().Add(-2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	x := NewPersistentIndex(client, time.Hour, IndexStores{Projects: store})
+	if _, err := x.FindProjectByCode(ctx, "MW"); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("listed projects %d times, want 1 for a stale store", n)
+	}
+	if loadedAt, _ := store.LoadedAt(ctx); time.Since(loadedAt) > time.Minute {
+		t.Errorf("store loaded at %v, want it updated", loadedAt)
+	}
+}
+
+func TestPersistDirectory(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		io.WriteString(w, `{"users": [{"id": 1782959, "email": "kim@example.com"}], "page": 1, "total_pages": 1, "total_entries": 1}`)
+	}))
+	store := NewMemoryStore(func(u User) UserID { return u.ID })
+	if err := store.Upsert(ctx, User{ID: 1782884, Email: "sam@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetLoadedAt(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	client.Users.PersistDirectory(store, time.Hour)
+	u, err := client.Users.FindByEmail(ctx, "SAM@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != 1782884 {
+		t.Errorf("user ID = %d, want 1782884", u.ID)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("listed users %d times, want the directory seeded from the store", n)
+	}
+}
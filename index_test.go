@@ -0,0 +1,111 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const projectsPage = `{"projects": [{"id": 14307913, "name": "Marketing Website", "code": "MW"}], "page": 1, "total_pages": 1, "total_entries": 1}`
+
+// blockingProjects serves the projects listing once release is closed,
+// counting the listings requested and closing started when the first one
+// arrives.
+func blockingProjects(requests *atomic.Int32, started chan<- struct{}, release <-chan struct{}) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		io.WriteString(w, projectsPage)
+	})
+	mux.HandleFunc("GET /v2/clients", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"clients": [{"id": 5735776, "name": "123 Industries"}], "page": 1, "total_pages": 1, "total_entries": 1}`)
+	})
+	return mux
+}
+
+func TestIndexSharesListing(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	x := NewIndex(newTestClient(t, blockingProjects(&requests, started, release)), 0)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for range 20 {
+		wg.Go(func() {
+			_, err := x.FindProjectByCode(context.Background(), "mw")
+			errs <- err
+		})
+	}
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("listed projects %d times, want 1", n)
+	}
+}
+
+func TestIndexCancelledLookup(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	x := NewIndex(newTestClient(t, blockingProjects(&requests, started, release)), 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := x.FindProjectByCode(ctx, "MW")
+		first <- err
+	}()
+	<-started
+
+	second := make(chan error, 1)
+	go func() {
+		_, err := x.FindProjectByCode(context.Background(), "MW")
+		second <- err
+	}()
+
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled look-up: %v, want context.Canceled", err)
+	}
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("second look-up failed with the first caller's cancellation: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("listed projects %d times, want 1", n)
+	}
+}
+
+func TestIndexLoadsResourcesIndependently(t *testing.T) {
+	var requests atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	x := NewIndex(newTestClient(t, blockingProjects(&requests, started, release)), 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go x.FindProjectByCode(ctx, "MW")
+	<-started
+
+	// The projects listing is still blocked; clients must not wait on it.
+	c, err := x.FindClientByName(context.Background(), "123 industries")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ID != 5735776 {
+		t.Errorf("client ID = %d, want 5735776", c.ID)
+	}
+}
@@ -0,0 +1,194 @@
+package harvest
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Harvest applies invoice discounts and taxes as follows:
+//
+//   - Tax, Tax2 and Discount are percentages set once on the invoice. Line
+//     items cannot carry their own rates.
+//   - A line item's Taxed and Taxed2 flags choose whether the invoice's Tax
+//     and Tax2 apply to that line. Flagging a line on an invoice without the
+//     matching rate is accepted by the API but has no effect.
+//   - The discount reduces the subtotal, and taxes are charged on the
+//     discounted amount of the taxed lines.
+//
+// InvoiceBuilder assembles an InvoiceCreateRequest that follows these rules
+// and reports the totals Harvest is expected to compute.
+
+// InvoiceTotals are the amounts Harvest is expected to compute for an
+// invoice, rounded to two decimal places.
+type InvoiceTotals struct {
+	Subtotal       decimal.Decimal
+	DiscountAmount decimal.Decimal
+	TaxAmount      decimal.Decimal
+	Tax2Amount     decimal.Decimal
+	Total          decimal.Decimal
+}
+
+// InvoiceBuilder builds an InvoiceCreateRequest with consistent tax and
+// discount settings.
+//
+// Experimental: this API may change in minor releases.
+type InvoiceBuilder struct {
+	req InvoiceCreateRequest
+}
+
+// NewInvoiceBuilder starts an invoice for the given client.
+func NewInvoiceBuilder(clientID ClientID) *InvoiceBuilder {
+	return &InvoiceBuilder{req: InvoiceCreateRequest{ClientID: clientID}}
+}
+
+// Subject sets the invoice subject.
+func (b *InvoiceBuilder) Subject(subject string) *InvoiceBuilder {
+	b.req.Subject = subject
+	return b
+}
+
+// Number sets the invoice number. Harvest assigns one when it is empty.
+func (b *InvoiceBuilder) Number(number string) *InvoiceBuilder {
+	b.req.Number = number
+	return b
+}
+
+// PurchaseOrder sets the purchase order number.
+func (b *InvoiceBuilder) PurchaseOrder(po string) *InvoiceBuilder {
+	b.req.PurchaseOrder = po
+	return b
+}
+
+// Notes sets the invoice notes.
+func (b *InvoiceBuilder) Notes(notes string) *InvoiceBuilder {
+	b.req.Notes = notes
+	return b
+}
+
+// Currency sets the invoice currency code.
+func (b *InvoiceBuilder) Currency(currency string) *InvoiceBuilder {
+	b.req.Currency = currency
+	return b
+}
+
+// IssueDate sets the issue date in YYYY-MM-DD format.
+func (b *InvoiceBuilder) IssueDate(date string) *InvoiceBuilder {
+	b.req.IssueDate = date
+	return b
+}
+
+// DueDate sets the due date in YYYY-MM-DD format.
+func (b *InvoiceBuilder) DueDate(date string) *InvoiceBuilder {
+	b.req.DueDate = date
+	return b
+}
+
+// IssuedOn sets the issue date from a Date.
+func (b *InvoiceBuilder) IssuedOn(date Date) *InvoiceBuilder {
+	b.req.IssueDate = dateString(date)
+	return b
+}
+
+// DueOn sets the due date from a Date.
+func (b *InvoiceBuilder) DueOn(date Date) *InvoiceBuilder {
+	b.req.DueDate = dateString(date)
+	return b
+}
+
+// PaymentTerm sets the payment term, e.g. "net 30".
+func (b *InvoiceBuilder) PaymentTerm(term string) *InvoiceBuilder {
+	b.req.PaymentTerm = term
+	return b
+}
+
+// PaymentOptions sets the online payment methods offered to the client.
+func (b *InvoiceBuilder) PaymentOptions(options ...PaymentOption) *InvoiceBuilder {
+	b.req.PaymentOptions = options
+	return b
+}
+
+// Tax sets the invoice-level tax percentage applied to lines added with
+// Taxed set.
+func (b *InvoiceBuilder) Tax(percent decimal.Decimal) *InvoiceBuilder {
+	b.req.Tax = &percent
+	return b
+}
+
+// Tax2 sets the second invoice-level tax percentage applied to lines added
+// with Taxed2 set.
+func (b *InvoiceBuilder) Tax2(percent decimal.Decimal) *InvoiceBuilder {
+	b.req.Tax2 = &percent
+	return b
+}
+
+// Discount sets the percentage taken off the subtotal before tax.
+func (b *InvoiceBuilder) Discount(percent decimal.Decimal) *InvoiceBuilder {
+	b.req.Discount = &percent
+	return b
+}
+
+// AddLine appends a line item.
+func (b *InvoiceBuilder) AddLine(item InvoiceLineItemRequest) *InvoiceBuilder {
+	b.req.LineItems = append(b.req.LineItems, item)
+	return b
+}
+
+// Totals returns the amounts Harvest is expected to compute for the
+// invoice as currently built.
+func (b *InvoiceBuilder) Totals() InvoiceTotals {
+	return invoiceTotals(&b.req)
+}
+
+// Build validates the invoice and returns the request. Besides the checks
+// made by InvoiceCreateRequest.Validate, it rejects invoices without line
+// items and lines flagged Taxed or Taxed2 when the invoice has no matching
+// rate, since Harvest would silently leave those lines untaxed.
+func (b *InvoiceBuilder) Build() (*InvoiceCreateRequest, error) {
+	req := b.req
+	req.LineItems = append([]InvoiceLineItemRequest(nil), b.req.LineItems...)
+
+	var errs fieldErrors
+	if err := req.Validate(); err != nil {
+		errs = append(errs, err.(*ValidationError).Errors...)
+	}
+	errs.require(len(req.LineItems) > 0, "line_items", "at least one line item is required")
+	for i, item := range req.LineItems {
+		if item.Taxed != nil && *item.Taxed {
+			errs.require(!valueOf(req.Tax).IsZero(), fmt.Sprintf("line_items[%d].taxed", i), "is set but the invoice has no tax")
+		}
+		if item.Taxed2 != nil && *item.Taxed2 {
+			errs.require(!valueOf(req.Tax2).IsZero(), fmt.Sprintf("line_items[%d].taxed2", i), "is set but the invoice has no tax2")
+		}
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// invoiceTotals computes the totals for req using Harvest's rules.
+func invoiceTotals(req *InvoiceCreateRequest) InvoiceTotals {
+	hundred := decimal.NewFromInt(100)
+	discount, tax, tax2 := valueOf(req.Discount), valueOf(req.Tax), valueOf(req.Tax2)
+	keep := hundred.Sub(discount).Div(hundred)
+
+	var t InvoiceTotals
+	taxed, taxed2 := decimal.Zero, decimal.Zero
+	for _, item := range req.LineItems {
+		amount := item.Quantity.Mul(item.UnitPrice).Round(2)
+		t.Subtotal = t.Subtotal.Add(amount)
+		if item.Taxed != nil && *item.Taxed {
+			taxed = taxed.Add(amount)
+		}
+		if item.Taxed2 != nil && *item.Taxed2 {
+			taxed2 = taxed2.Add(amount)
+		}
+	}
+
+	t.DiscountAmount = t.Subtotal.Mul(discount).Div(hundred).Round(2)
+	t.TaxAmount = taxed.Mul(keep).Mul(tax).Div(hundred).Round(2)
+	t.Tax2Amount = taxed2.Mul(keep).Mul(tax2).Div(hundred).Round(2)
+	t.Total = t.Subtotal.Sub(t.DiscountAmount).Add(t.TaxAmount).Add(t.Tax2Amount)
+	return t
+}
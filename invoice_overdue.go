@@ -0,0 +1,40 @@
+package harvest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+)
+
+// OverdueInvoice is an open invoice past its due date.
+type OverdueInvoice struct {
+	Invoice Invoice `json:"invoice"`
+	// DaysOverdue is the number of days between the due date and the date
+	// passed to ListOverdue.
+	DaysOverdue int `json:"days_overdue"`
+}
+
+// ListOverdue returns the open invoices due before asOf, most overdue
+// first.
+//
+// Experimental: this API may change in minor releases.
+func (s *InvoicesService) ListOverdue(ctx context.Context, asOf Date) ([]OverdueInvoice, error) {
+	var overdue []OverdueInvoice
+	for invoice, err := range s.All(ctx, &InvoiceListOptions{State: InvoiceStateOpen}) {
+		if err != nil {
+			return nil, err
+		}
+		if invoice.DueDate == nil || !invoice.DueDate.BeforeDate(asOf) {
+			continue
+		}
+		overdue = append(overdue, OverdueInvoice{
+			Invoice:     invoice,
+			DaysOverdue: int(asOf.Sub(invoice.DueDate.Time) / (24 * time.Hour)),
+		})
+	}
+	slices.SortStableFunc(overdue, func(a, b OverdueInvoice) int {
+		return cmp.Or(cmp.Compare(b.DaysOverdue, a.DaysOverdue), cmp.Compare(a.Invoice.ID, b.Invoice.ID))
+	})
+	return overdue, nil
+}
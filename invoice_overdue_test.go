@@ -0,0 +1,43 @@
+package harvest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestListOverdue(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("state"); got != "open" {
+			t.Errorf("state = %q, want open", got)
+		}
+		io.WriteString(w, `{"invoices": [
+			{"id": 1, "number": "1001", "due_date": "2026-10-16"},
+			{"id": 5, "number": "1005", "due_date": "2026-10-06"},
+			{"id": 3, "number": "1003", "due_date": null},
+			{"id": 4, "number": "1004", "due_date": "2026-09-16"},
+			{"id": 2, "number": "1002", "due_date": "2026-10-06"},
+			{"id": 6, "number": "1006", "due_date": "2026-10-15"}
+		], "page": 1, "total_pages": 1, "total_entries": 6}`)
+	})
+	c := newTestClient(t, handler)
+
+	overdue, err := c.Invoices.ListOverdue(context.Background(), NewDate(2026, time.October, 16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		id   InvoiceID
+		days int
+	}{{4, 30}, {2, 10}, {5, 10}, {6, 1}}
+	if len(overdue) != len(want) {
+		t.Fatalf("got %d overdue invoices, want %d: %+v", len(overdue), len(want), overdue)
+	}
+	for i, w := range want {
+		if got := overdue[i]; got.Invoice.ID != w.id || got.DaysOverdue != w.days {
+			t.Errorf("overdue[%d] = invoice %d, %d days; want invoice %d, %d days", i, got.Invoice.ID, got.DaysOverdue, w.id, w.days)
+		}
+	}
+}
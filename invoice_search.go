@@ -0,0 +1,43 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// FindByNumber returns the invoice with the given number. The API has no
+// number filter, so invoices are listed until it is found. It returns an
+// error wrapping ErrNotFound when no invoice has that number.
+//
+// Experimental: this API may change in minor releases.
+func (s *InvoicesService) FindByNumber(ctx context.Context, number string) (*Invoice, error) {
+	for invoice, err := range s.All(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if number != "" && invoice.Number == number {
+			return &invoice, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: invoice number %q", ErrNotFound, number)
+}
+
+// Search returns the invoices matching opts whose subject or purchase
+// order contains query, ignoring case. Matching is done on the listed
+// invoices, since the API has no text search; narrow opts to list fewer.
+//
+// Experimental: this API may change in minor releases.
+func (s *InvoicesService) Search(ctx context.Context, query string, opts *InvoiceListOptions) ([]Invoice, error) {
+	query = strings.ToLower(query)
+	var matched []Invoice
+	for invoice, err := range s.All(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(strings.ToLower(invoice.Subject), query) || strings.Contains(strings.ToLower(invoice.PurchaseOrder), query) {
+			matched = append(matched, invoice)
+		}
+	}
+	return matched, nil
+}
@@ -2,8 +2,13 @@ package harvest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"net/url"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // InvoicesService handles communication with the invoice related
@@ -15,12 +20,27 @@ type InvoicesService struct {
 // InvoiceListOptions specifies optional parameters to the List method.
 type InvoiceListOptions struct {
 	ListOptions
-	ClientID     int64  `url:"client_id,omitempty"`
-	ProjectID    int64  `url:"project_id,omitempty"`
-	State        string `url:"state,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
-	From         string `url:"from,omitempty"`
-	To           string `url:"to,omitempty"`
+	ClientID  ClientID     `url:"client_id,omitempty"`
+	ProjectID ProjectID    `url:"project_id,omitempty"`
+	State     InvoiceState `url:"state,omitempty"`
+	// From and To bound the issue date, in YYYY-MM-DD format. See
+	// IssuedBetween and IssuedInMonth.
+	From string `url:"from,omitempty"`
+	To   string `url:"to,omitempty"`
+}
+
+// IssuedBetween limits the list to invoices issued from from to to,
+// inclusive. A zero Date leaves that end of the range open.
+func (o *InvoiceListOptions) IssuedBetween(from, to Date) *InvoiceListOptions {
+	o.From = dateString(from)
+	o.To = dateString(to)
+	return o
+}
+
+// IssuedInMonth limits the list to invoices issued in the given month.
+func (o *InvoiceListOptions) IssuedInMonth(year int, month time.Month) *InvoiceListOptions {
+	first := NewDate(year, month, 1)
+	return o.IssuedBetween(first, Date{first.AddDate(0, 1, -1)})
 }
 
 // InvoiceList represents a list of invoices.
@@ -29,32 +49,40 @@ type InvoiceList struct {
 	Paginated[Invoice]
 }
 
+func (l *InvoiceList) page() *Paginated[Invoice] {
+	l.Invoices = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Invoices and Items.
+func (l *InvoiceList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "invoices", &l.Paginated, &l.Invoices)
+}
+
 // ListPage returns a single page of invoices.
 func (s *InvoicesService) ListPage(ctx context.Context, opts *InvoiceListOptions) (*InvoiceList, error) {
-	u, err := addOptions("invoices", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Invoice, InvoiceList](ctx, s.client, "invoices", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all invoices across all pages.
+func (s *InvoicesService) List(ctx context.Context, opts *InvoiceListOptions) ([]Invoice, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var invoices InvoiceList
-	_, err = s.client.Do(ctx, req, &invoices)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *InvoicesService) ListWithTotals(ctx context.Context, opts *InvoiceListOptions) (*ListResult[Invoice], error) {
+	if opts == nil {
+		opts = &InvoiceListOptions{}
 	}
-
-	// Copy invoices to Items for pagination
-	invoices.Items = invoices.Invoices
-
-	return &invoices, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Invoice, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all invoices across all pages.
-func (s *InvoicesService) List(ctx context.Context, opts *InvoiceListOptions) ([]Invoice, error) {
+// All returns an iterator over all invoices, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *InvoicesService) All(ctx context.Context, opts *InvoiceListOptions) iter.Seq2[Invoice, error] {
 	if opts == nil {
 		opts = &InvoiceListOptions{}
 	}
@@ -65,58 +93,141 @@ func (s *InvoicesService) List(ctx context.Context, opts *InvoiceListOptions) ([
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allInvoices []Invoice
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allInvoices = append(allInvoices, result.Invoices...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Invoice](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*InvoiceList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all invoices on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *InvoicesService) Stream(ctx context.Context, opts *InvoiceListOptions) (<-chan Invoice, <-chan error) {
+	if opts == nil {
+		opts = &InvoiceListOptions{}
 	}
-
-	return allInvoices, nil
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // Get retrieves a specific invoice.
-func (s *InvoicesService) Get(ctx context.Context, invoiceID int64) (*Invoice, error) {
+func (s *InvoicesService) Get(ctx context.Context, invoiceID InvoiceID) (*Invoice, error) {
 	return Get[Invoice](ctx, s.client, fmt.Sprintf("invoices/%d", invoiceID))
 }
 
 // InvoiceCreateRequest represents a request to create an invoice.
 type InvoiceCreateRequest struct {
-	ClientID      int64                    `json:"client_id"`
-	EstimateID    int64                    `json:"estimate_id,omitempty"`
-	Number        string                   `json:"number,omitempty"`
-	PurchaseOrder string                   `json:"purchase_order,omitempty"`
-	Tax           float64                  `json:"tax,omitempty"`
-	Tax2          float64                  `json:"tax2,omitempty"`
-	Discount      float64                  `json:"discount,omitempty"`
-	Subject       string                   `json:"subject,omitempty"`
-	Notes         string                   `json:"notes,omitempty"`
-	Currency      string                   `json:"currency,omitempty"`
-	IssueDate     string                   `json:"issue_date,omitempty"`
-	DueDate       string                   `json:"due_date,omitempty"`
-	PaymentTerm   string                   `json:"payment_term,omitempty"`
-	LineItems     []InvoiceLineItemRequest `json:"line_items,omitempty"`
-}
-
-// InvoiceLineItemRequest represents a line item in an invoice request.
+	ClientID       ClientID                 `json:"client_id"`
+	EstimateID     EstimateID               `json:"estimate_id,omitempty"`
+	Number         string                   `json:"number,omitempty"`
+	PurchaseOrder  string                   `json:"purchase_order,omitempty"`
+	Tax            *decimal.Decimal         `json:"tax,omitempty"`
+	Tax2           *decimal.Decimal         `json:"tax2,omitempty"`
+	Discount       *decimal.Decimal         `json:"discount,omitempty"`
+	Subject        string                   `json:"subject,omitempty"`
+	Notes          string                   `json:"notes,omitempty"`
+	Currency       string                   `json:"currency,omitempty"`
+	IssueDate      string                   `json:"issue_date,omitempty"`
+	DueDate        string                   `json:"due_date,omitempty"`
+	PaymentTerm    string                   `json:"payment_term,omitempty"`
+	PaymentOptions []PaymentOption          `json:"payment_options,omitempty"`
+	LineItems      []InvoiceLineItemRequest `json:"line_items,omitempty"`
+	// LineItemsImport has Harvest create line items from the uninvoiced
+	// time and expenses of projects instead of sending them in LineItems.
+	LineItemsImport *InvoiceLineItemsImport `json:"line_items_import,omitempty"`
+}
+
+// InvoiceLineItemsImport selects the uninvoiced time and expenses Harvest
+// imports as line items when creating an invoice.
+type InvoiceLineItemsImport struct {
+	ProjectIDs []ProjectID            `json:"project_ids"`
+	Time       *InvoiceTimeImport     `json:"time,omitempty"`
+	Expenses   *InvoiceExpensesImport `json:"expenses,omitempty"`
+}
+
+// InvoiceTimeImport imports uninvoiced time. SummaryType is "project",
+// "task", "people" or "detailed".
+type InvoiceTimeImport struct {
+	SummaryType string `json:"summary_type"`
+	From        string `json:"from,omitempty"`
+	To          string `json:"to,omitempty"`
+}
+
+// InvoiceExpensesImport imports uninvoiced expenses. SummaryType is
+// "project", "category", "people" or "detailed".
+type InvoiceExpensesImport struct {
+	SummaryType   string `json:"summary_type"`
+	From          string `json:"from,omitempty"`
+	To            string `json:"to,omitempty"`
+	AttachReceipt bool   `json:"attach_receipt,omitempty"`
+}
+
+// SetDates sets the issue and due dates from Dates. A zero Date leaves
+// that date unset.
+func (r *InvoiceCreateRequest) SetDates(issueDate, dueDate Date) *InvoiceCreateRequest {
+	r.IssueDate = dateString(issueDate)
+	r.DueDate = dateString(dueDate)
+	return r
+}
+
+// Validate checks that the required fields are set.
+func (r *InvoiceCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ClientID != 0, "client_id", "is required")
+	errs.currency(r.Currency, "currency")
+	errs.date(r.IssueDate, "issue_date")
+	errs.date(r.DueDate, "due_date")
+	errs.percent(r.Tax, "tax")
+	errs.percent(r.Tax2, "tax2")
+	errs.percent(r.Discount, "discount")
+	errs.paymentOptions(r.PaymentOptions)
+	for i, item := range r.LineItems {
+		errs.lineItem(i, item.ID, item.Kind, item.Destroy)
+	}
+	if r.LineItemsImport != nil {
+		errs.require(len(r.LineItemsImport.ProjectIDs) > 0, "line_items_import.project_ids", "is required")
+	}
+	return errs.err()
+}
+
+// InvoiceLineItemRequest represents a line item in an invoice request. In
+// an update, items with an ID change that existing line item, items
+// without one are added, and line items that aren't listed are kept.
 type InvoiceLineItemRequest struct {
-	ProjectID   int64   `json:"project_id,omitempty"`
-	Kind        string  `json:"kind"`
-	Description string  `json:"description"`
-	Quantity    float64 `json:"quantity"`
-	UnitPrice   float64 `json:"unit_price"`
-	Taxed       *bool   `json:"taxed,omitempty"`
-	Taxed2      *bool   `json:"taxed2,omitempty"`
+	// ID is the line item to update or remove. Leave it zero to add a line
+	// item.
+	ID          int64           `json:"id,omitempty"`
+	ProjectID   ProjectID       `json:"project_id,omitempty"`
+	Kind        LineItemKind    `json:"kind,omitempty"`
+	Description string          `json:"description"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Taxed       *bool           `json:"taxed,omitempty"`
+	Taxed2      *bool           `json:"taxed2,omitempty"`
+	// Destroy removes the line item with ID; the other fields are not sent.
+	Destroy bool `json:"_destroy,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, sending only the ID of line items
+// being removed.
+func (r InvoiceLineItemRequest) MarshalJSON() ([]byte, error) {
+	if r.Destroy {
+		return json.Marshal(struct {
+			ID      int64 `json:"id"`
+			Destroy bool  `json:"_destroy"`
+		}{r.ID, true})
+	}
+	type plain InvoiceLineItemRequest
+	return json.Marshal(plain(r))
+}
+
+// paymentOptions records errors for unknown payment options.
+func (f *fieldErrors) paymentOptions(options []PaymentOption) {
+	for i, o := range options {
+		f.require(o.Valid(), fmt.Sprintf("payment_options[%d]", i), "is not a known payment option")
+	}
 }
 
 // Create creates a new invoice.
@@ -126,29 +237,87 @@ func (s *InvoicesService) Create(ctx context.Context, invoice *InvoiceCreateRequ
 
 // InvoiceUpdateRequest represents a request to update an invoice.
 type InvoiceUpdateRequest struct {
-	ClientID      int64                    `json:"client_id,omitempty"`
-	EstimateID    int64                    `json:"estimate_id,omitempty"`
-	Number        string                   `json:"number,omitempty"`
-	PurchaseOrder string                   `json:"purchase_order,omitempty"`
-	Tax           float64                  `json:"tax,omitempty"`
-	Tax2          float64                  `json:"tax2,omitempty"`
-	Discount      float64                  `json:"discount,omitempty"`
-	Subject       string                   `json:"subject,omitempty"`
-	Notes         string                   `json:"notes,omitempty"`
-	Currency      string                   `json:"currency,omitempty"`
-	IssueDate     string                   `json:"issue_date,omitempty"`
-	DueDate       string                   `json:"due_date,omitempty"`
-	PaymentTerm   string                   `json:"payment_term,omitempty"`
-	LineItems     []InvoiceLineItemRequest `json:"line_items,omitempty"`
+	ClientID       ClientID                  `json:"client_id,omitempty"`
+	EstimateID     EstimateID                `json:"estimate_id,omitempty"`
+	Number         string                    `json:"number,omitempty"`
+	PurchaseOrder  Nullable[string]          `json:"purchase_order,omitzero"`
+	Tax            Nullable[decimal.Decimal] `json:"tax,omitzero"`
+	Tax2           Nullable[decimal.Decimal] `json:"tax2,omitzero"`
+	Discount       Nullable[decimal.Decimal] `json:"discount,omitzero"`
+	Subject        Nullable[string]          `json:"subject,omitzero"`
+	Notes          Nullable[string]          `json:"notes,omitzero"`
+	Currency       string                    `json:"currency,omitempty"`
+	IssueDate      string                    `json:"issue_date,omitempty"`
+	DueDate        string                    `json:"due_date,omitempty"`
+	PaymentTerm    string                    `json:"payment_term,omitempty"`
+	PaymentOptions []PaymentOption           `json:"payment_options,omitempty"`
+	LineItems      []InvoiceLineItemRequest  `json:"line_items,omitempty"`
+}
+
+// ClientURL returns the link clients use to view and pay the invoice, built
+// from the account's base URI and the invoice's client key. It returns ""
+// when the invoice has no client key.
+func (i *Invoice) ClientURL(company *Company) string {
+	if i.ClientKey == "" {
+		return ""
+	}
+	return strings.TrimSuffix(company.BaseURI, "/") + "/client/invoices/" + i.ClientKey
+}
+
+// SetDates sets the issue and due dates from Dates. A zero Date leaves
+// that date unchanged.
+func (r *InvoiceUpdateRequest) SetDates(issueDate, dueDate Date) *InvoiceUpdateRequest {
+	r.IssueDate = dateString(issueDate)
+	r.DueDate = dateString(dueDate)
+	return r
+}
+
+// Validate checks the currency, dates and payment options, and that line items being
+// added have a kind and line items being removed have an ID.
+func (r *InvoiceUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.currency(r.Currency, "currency")
+	errs.date(r.IssueDate, "issue_date")
+	errs.date(r.DueDate, "due_date")
+	errs.paymentOptions(r.PaymentOptions)
+	for i, item := range r.LineItems {
+		errs.lineItem(i, item.ID, item.Kind, item.Destroy)
+	}
+	return errs.err()
 }
 
 // Update updates an invoice.
-func (s *InvoicesService) Update(ctx context.Context, invoiceID int64, invoice *InvoiceUpdateRequest) (*Invoice, error) {
+func (s *InvoicesService) Update(ctx context.Context, invoiceID InvoiceID, invoice *InvoiceUpdateRequest) (*Invoice, error) {
 	return Update[Invoice](ctx, s.client, fmt.Sprintf("invoices/%d", invoiceID), invoice)
 }
 
+// AddLineItem adds a line item to an invoice, leaving its other line items
+// as they are.
+func (s *InvoicesService) AddLineItem(ctx context.Context, invoiceID InvoiceID, item InvoiceLineItemRequest) (*Invoice, error) {
+	item.ID = 0
+	item.Destroy = false
+	return s.Update(ctx, invoiceID, &InvoiceUpdateRequest{LineItems: []InvoiceLineItemRequest{item}})
+}
+
+// UpdateLineItem replaces the description, quantity and unit price of one
+// line item, and its project, kind and taxes when set in item, leaving the
+// invoice's other line items as they are.
+func (s *InvoicesService) UpdateLineItem(ctx context.Context, invoiceID InvoiceID, lineItemID int64, item InvoiceLineItemRequest) (*Invoice, error) {
+	item.ID = lineItemID
+	item.Destroy = false
+	return s.Update(ctx, invoiceID, &InvoiceUpdateRequest{LineItems: []InvoiceLineItemRequest{item}})
+}
+
+// RemoveLineItem removes one line item from an invoice, leaving its other
+// line items as they are.
+func (s *InvoicesService) RemoveLineItem(ctx context.Context, invoiceID InvoiceID, lineItemID int64) (*Invoice, error) {
+	return s.Update(ctx, invoiceID, &InvoiceUpdateRequest{
+		LineItems: []InvoiceLineItemRequest{{ID: lineItemID, Destroy: true}},
+	})
+}
+
 // Delete deletes an invoice.
-func (s *InvoicesService) Delete(ctx context.Context, invoiceID int64) error {
+func (s *InvoicesService) Delete(ctx context.Context, invoiceID InvoiceID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("invoices/%d", invoiceID))
 }
 
@@ -160,7 +329,6 @@ type InvoiceMessageRequest struct {
 // InvoiceMessageListOptions specifies optional parameters for listing invoice messages.
 type InvoiceMessageListOptions struct {
 	ListOptions
-	UpdatedSince string `url:"updated_since,omitempty"`
 }
 
 // InvoiceMessageList represents a list of invoice messages.
@@ -169,32 +337,40 @@ type InvoiceMessageList struct {
 	Paginated[InvoiceMessage]
 }
 
-// ListMessagesPage returns a single page of messages for an invoice.
-func (s *InvoicesService) ListMessagesPage(ctx context.Context, invoiceID int64, opts *InvoiceMessageListOptions) (*InvoiceMessageList, error) {
-	u, err := addOptions(fmt.Sprintf("invoices/%d/messages", invoiceID), opts)
-	if err != nil {
-		return nil, err
-	}
+func (l *InvoiceMessageList) page() *Paginated[InvoiceMessage] {
+	l.InvoiceMessages = l.Items
+	return &l.Paginated
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// UnmarshalJSON implements json.Unmarshaler, filling both InvoiceMessages and Items.
+func (l *InvoiceMessageList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "invoice_messages", &l.Paginated, &l.InvoiceMessages)
+}
 
-	var messages InvoiceMessageList
-	_, err = s.client.Do(ctx, req, &messages)
-	if err != nil {
-		return nil, err
-	}
+// ListMessagesPage returns a single page of messages for an invoice.
+func (s *InvoicesService) ListMessagesPage(ctx context.Context, invoiceID InvoiceID, opts *InvoiceMessageListOptions) (*InvoiceMessageList, error) {
+	return listPage[InvoiceMessage, InvoiceMessageList](ctx, s.client, fmt.Sprintf("invoices/%d/messages", invoiceID), opts)
+}
 
-	// Copy messages to Items for pagination
-	messages.Items = messages.InvoiceMessages
+// ListMessages returns all messages for an invoice across all pages.
+func (s *InvoicesService) ListMessages(ctx context.Context, invoiceID InvoiceID, opts *InvoiceMessageListOptions) ([]InvoiceMessage, error) {
+	return collect(s.AllMessages(ctx, invoiceID, opts))
+}
 
-	return &messages, nil
+// ListMessagesWithTotals is like ListMessages but also returns the totals and rate
+// limit reported by the API.
+func (s *InvoicesService) ListMessagesWithTotals(ctx context.Context, invoiceID InvoiceID, opts *InvoiceMessageListOptions) (*ListResult[InvoiceMessage], error) {
+	if opts == nil {
+		opts = &InvoiceMessageListOptions{}
+	}
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[InvoiceMessage, error] {
+		return s.AllMessages(ctx, invoiceID, opts)
+	})
 }
 
-// ListMessages returns all messages for an invoice across all pages.
-func (s *InvoicesService) ListMessages(ctx context.Context, invoiceID int64, opts *InvoiceMessageListOptions) ([]InvoiceMessage, error) {
+// AllMessages returns an iterator over all messages for an invoice, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *InvoicesService) AllMessages(ctx context.Context, invoiceID InvoiceID, opts *InvoiceMessageListOptions) iter.Seq2[InvoiceMessage, error] {
 	if opts == nil {
 		opts = &InvoiceMessageListOptions{}
 	}
@@ -205,52 +381,120 @@ func (s *InvoicesService) ListMessages(ctx context.Context, invoiceID int64, opt
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allMessages []InvoiceMessage
+	return all[InvoiceMessage](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*InvoiceMessageList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListMessagesPage(ctx, invoiceID, &o)
+	})
+}
+
+// StreamMessages sends all messages for an invoice on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *InvoicesService) StreamMessages(ctx context.Context, invoiceID InvoiceID, opts *InvoiceMessageListOptions) (<-chan InvoiceMessage, <-chan error) {
+	if opts == nil {
+		opts = &InvoiceMessageListOptions{}
+	}
+	seq := s.AllMessages(ctx, invoiceID, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
 
-	for {
-		result, err := s.ListMessagesPage(ctx, invoiceID, opts)
-		if err != nil {
-			return nil, err
-		}
+// InvoiceMessageTemplateOptions selects which email template NewMessage
+// returns. The invoice email is returned when neither is set.
+type InvoiceMessageTemplateOptions struct {
+	ThankYou bool `url:"thank_you,omitempty"`
+	Reminder bool `url:"reminder,omitempty"`
+}
 
-		allMessages = append(allMessages, result.InvoiceMessages...)
+// InvoiceMessageRecipient is a suggested recipient of an invoice email.
+type InvoiceMessageRecipient struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
 
-		if !result.HasNextPage() {
-			break
-		}
+// InvoiceMessageTemplate is the subject, body and recipients Harvest
+// suggests for a new invoice email.
+type InvoiceMessageTemplate struct {
+	InvoiceID  InvoiceID                 `json:"invoice_id"`
+	Subject    string                    `json:"subject"`
+	Body       string                    `json:"body"`
+	Reminder   bool                      `json:"reminder"`
+	ThankYou   bool                      `json:"thank_you"`
+	Recipients []InvoiceMessageRecipient `json:"recipients"`
+}
 
-		opts.Page = *result.NextPage
+// NewMessage retrieves the default subject, body and recipients for an
+// invoice email, as shown in the Harvest UI before sending.
+func (s *InvoicesService) NewMessage(ctx context.Context, invoiceID InvoiceID, opts *InvoiceMessageTemplateOptions) (*InvoiceMessageTemplate, error) {
+	u, err := addOptions(fmt.Sprintf("invoices/%d/messages/new", invoiceID), opts)
+	if err != nil {
+		return nil, err
 	}
-
-	return allMessages, nil
+	return Get[InvoiceMessageTemplate](ctx, s.client, u)
 }
 
 // MarkAsSent marks a draft invoice as sent.
-func (s *InvoicesService) MarkAsSent(ctx context.Context, invoiceID int64) (*InvoiceMessage, error) {
+func (s *InvoicesService) MarkAsSent(ctx context.Context, invoiceID InvoiceID) (*InvoiceMessage, error) {
 	req := &InvoiceMessageRequest{EventType: "send"}
 	return Create[InvoiceMessage](ctx, s.client, fmt.Sprintf("invoices/%d/messages", invoiceID), req)
 }
 
 // MarkAsClosed marks an invoice as closed.
-func (s *InvoicesService) MarkAsClosed(ctx context.Context, invoiceID int64) (*Invoice, error) {
+func (s *InvoicesService) MarkAsClosed(ctx context.Context, invoiceID InvoiceID) (*Invoice, error) {
 	return Update[Invoice](ctx, s.client, fmt.Sprintf("invoices/%d/close", invoiceID), nil)
 }
 
 // Reopen reopens a closed invoice.
-func (s *InvoicesService) Reopen(ctx context.Context, invoiceID int64) (*Invoice, error) {
+func (s *InvoicesService) Reopen(ctx context.Context, invoiceID InvoiceID) (*Invoice, error) {
 	return Update[Invoice](ctx, s.client, fmt.Sprintf("invoices/%d/reopen", invoiceID), nil)
 }
 
 // MarkAsDraft marks an open invoice as a draft.
-func (s *InvoicesService) MarkAsDraft(ctx context.Context, invoiceID int64) (*InvoiceMessage, error) {
+func (s *InvoicesService) MarkAsDraft(ctx context.Context, invoiceID InvoiceID) (*InvoiceMessage, error) {
 	req := &InvoiceMessageRequest{EventType: "draft"}
 	return Create[InvoiceMessage](ctx, s.client, fmt.Sprintf("invoices/%d/messages", invoiceID), req)
 }
 
+// InvoicePaymentCreateRequest represents a request to record a payment.
+type InvoicePaymentCreateRequest struct {
+	Amount       decimal.Decimal `json:"amount"`
+	PaidDate     string          `json:"paid_date,omitempty"`
+	Notes        string          `json:"notes,omitempty"`
+	SendThankYou *bool           `json:"send_thank_you,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *InvoicePaymentCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Amount.IsPositive(), "amount", "must be positive")
+	errs.date(r.PaidDate, "paid_date")
+	return errs.err()
+}
+
+// CreatePayment records a payment against an invoice.
+func (s *InvoicesService) CreatePayment(ctx context.Context, invoiceID InvoiceID, payment *InvoicePaymentCreateRequest) (*InvoicePayment, error) {
+	return Create[InvoicePayment](ctx, s.client, fmt.Sprintf("invoices/%d/payments", invoiceID), payment)
+}
+
+// MarkAsPaid records an offline payment of the invoice's full due amount,
+// paid on paidDate, which marks it as paid. Notes may be empty. It returns
+// an error wrapping ErrValidation when nothing is due.
+func (s *InvoicesService) MarkAsPaid(ctx context.Context, invoiceID InvoiceID, paidDate Date, notes string) (*InvoicePayment, error) {
+	invoice, err := s.Get(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.CreatePayment(ctx, invoiceID, &InvoicePaymentCreateRequest{
+		Amount:   invoice.DueAmount,
+		PaidDate: dateString(paidDate),
+		Notes:    notes,
+	})
+}
+
 // InvoiceItemCategoryListOptions specifies optional parameters for listing invoice item categories.
 type InvoiceItemCategoryListOptions struct {
 	ListOptions
-	UpdatedSince string `url:"updated_since,omitempty"`
 }
 
 // InvoiceItemCategoryList represents a list of invoice item categories.
@@ -259,33 +503,42 @@ type InvoiceItemCategoryList struct {
 	Paginated[InvoiceItemCategory]
 }
 
+func (l *InvoiceItemCategoryList) page() *Paginated[InvoiceItemCategory] {
+	l.InvoiceItemCategories = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both InvoiceItemCategories and Items.
+func (l *InvoiceItemCategoryList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "invoice_item_categories", &l.Paginated, &l.InvoiceItemCategories)
+}
+
 // ListItemCategoriesPage returns a single page of invoice item categories.
 func (s *InvoicesService) ListItemCategoriesPage(ctx context.Context, opts *InvoiceItemCategoryListOptions) (*InvoiceItemCategoryList, error) {
-	u, err := addOptions("invoice_item_categories", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[InvoiceItemCategory, InvoiceItemCategoryList](ctx, s.client, "invoice_item_categories", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// ListItemCategories returns all invoice item categories across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *InvoicesService) ListItemCategories(ctx context.Context, opts *InvoiceItemCategoryListOptions) ([]InvoiceItemCategory, error) {
+	return collect(s.AllItemCategories(ctx, opts))
+}
 
-	var categories InvoiceItemCategoryList
-	_, err = s.client.Do(ctx, req, &categories)
-	if err != nil {
-		return nil, err
+// ListItemCategoriesWithTotals is like ListItemCategories but also returns the totals and rate
+// limit reported by the API.
+func (s *InvoicesService) ListItemCategoriesWithTotals(ctx context.Context, opts *InvoiceItemCategoryListOptions) (*ListResult[InvoiceItemCategory], error) {
+	if opts == nil {
+		opts = &InvoiceItemCategoryListOptions{}
 	}
-
-	// Copy categories to Items for pagination
-	categories.Items = categories.InvoiceItemCategories
-
-	return &categories, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[InvoiceItemCategory, error] {
+		return s.AllItemCategories(ctx, opts)
+	})
 }
 
-// ListItemCategories returns all invoice item categories across all pages.
+// AllItemCategories returns an iterator over all invoice item categories, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
 // This endpoint uses cursor-based pagination.
-func (s *InvoicesService) ListItemCategories(ctx context.Context, opts *InvoiceItemCategoryListOptions) ([]InvoiceItemCategory, error) {
+func (s *InvoicesService) AllItemCategories(ctx context.Context, opts *InvoiceItemCategoryListOptions) iter.Seq2[InvoiceItemCategory, error] {
 	if opts == nil {
 		opts = &InvoiceItemCategoryListOptions{}
 	}
@@ -294,60 +547,27 @@ func (s *InvoicesService) ListItemCategories(ctx context.Context, opts *InvoiceI
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allCategories []InvoiceItemCategory
+	return all[InvoiceItemCategory](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*InvoiceItemCategoryList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListItemCategoriesPage(ctx, &o)
+	})
+}
 
-	// Fetch first page
-	result, err := s.ListItemCategoriesPage(ctx, opts)
-	if err != nil {
-		return nil, err
-	}
-	allCategories = append(allCategories, result.InvoiceItemCategories...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Parse the URL to get path and query
-			u, err := url.Parse(nextURL)
-			if err != nil {
-				return nil, err
-			}
-			pathAndQuery := u.Path
-			if u.RawQuery != "" {
-				pathAndQuery += "?" + u.RawQuery
-			}
-
-			req, err := s.client.NewRequest(ctx, "GET", pathAndQuery, nil)
-			if err != nil {
-				return nil, err
-			}
-
-			var categories InvoiceItemCategoryList
-			_, err = s.client.Do(ctx, req, &categories)
-			if err != nil {
-				return nil, err
-			}
-			categories.Items = categories.InvoiceItemCategories
-			result = &categories
-			allCategories = append(allCategories, categories.InvoiceItemCategories...)
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = s.ListItemCategoriesPage(ctx, opts)
-			if err != nil {
-				return nil, err
-			}
-			allCategories = append(allCategories, result.InvoiceItemCategories...)
-		} else {
-			break
-		}
+// StreamItemCategories sends all invoice item categories on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *InvoicesService) StreamItemCategories(ctx context.Context, opts *InvoiceItemCategoryListOptions) (<-chan InvoiceItemCategory, <-chan error) {
+	if opts == nil {
+		opts = &InvoiceItemCategoryListOptions{}
 	}
-
-	return allCategories, nil
+	seq := s.AllItemCategories(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // GetItemCategory retrieves a specific invoice item category.
-func (s *InvoicesService) GetItemCategory(ctx context.Context, categoryID int64) (*InvoiceItemCategory, error) {
+func (s *InvoicesService) GetItemCategory(ctx context.Context, categoryID InvoiceItemCategoryID) (*InvoiceItemCategory, error) {
 	return Get[InvoiceItemCategory](ctx, s.client, fmt.Sprintf("invoice_item_categories/%d", categoryID))
 }
 
@@ -356,6 +576,13 @@ type InvoiceItemCategoryCreateRequest struct {
 	Name string `json:"name"`
 }
 
+// Validate checks that the required fields are set.
+func (r *InvoiceItemCategoryCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Name != "", "name", "is required")
+	return errs.err()
+}
+
 // CreateItemCategory creates a new invoice item category.
 func (s *InvoicesService) CreateItemCategory(ctx context.Context, category *InvoiceItemCategoryCreateRequest) (*InvoiceItemCategory, error) {
 	return Create[InvoiceItemCategory](ctx, s.client, "invoice_item_categories", category)
@@ -367,11 +594,11 @@ type InvoiceItemCategoryUpdateRequest struct {
 }
 
 // UpdateItemCategory updates an invoice item category.
-func (s *InvoicesService) UpdateItemCategory(ctx context.Context, categoryID int64, category *InvoiceItemCategoryUpdateRequest) (*InvoiceItemCategory, error) {
+func (s *InvoicesService) UpdateItemCategory(ctx context.Context, categoryID InvoiceItemCategoryID, category *InvoiceItemCategoryUpdateRequest) (*InvoiceItemCategory, error) {
 	return Update[InvoiceItemCategory](ctx, s.client, fmt.Sprintf("invoice_item_categories/%d", categoryID), category)
 }
 
 // DeleteItemCategory deletes an invoice item category.
-func (s *InvoicesService) DeleteItemCategory(ctx context.Context, categoryID int64) error {
+func (s *InvoicesService) DeleteItemCategory(ctx context.Context, categoryID InvoiceItemCategoryID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("invoice_item_categories/%d", categoryID))
 }
@@ -0,0 +1,250 @@
+package harvest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const harvestIDAuthorizeURL = "https://id.getharvest.com/oauth2/authorize"
+
+// LoginOptions configures Login.
+type LoginOptions struct {
+	// ClientID identifies the OAuth2 application registered in Harvest ID.
+	ClientID string
+	// ClientSecret is sent with the code exchange when set. Command-line
+	// tools that can't keep a secret leave it empty and rely on PKCE.
+	ClientSecret string
+	// RedirectURL is the application's registered redirect URL. It must be
+	// an http URL on localhost or 127.0.0.1 with an explicit port, which
+	// Login listens on for the redirect.
+	RedirectURL string
+	// OpenBrowser opens the authorization URL. It defaults to the
+	// platform's URL opener; set it to print the URL instead on machines
+	// without a browser.
+	OpenBrowser func(url string) error
+	// HTTPClient is used for the code exchange. A nil client uses one with
+	// the default timeout.
+	HTTPClient *http.Client
+}
+
+// LoginResult is the outcome of a successful Login.
+type LoginResult struct {
+	Token *Token
+	// AccountIDs and ForecastAccountIDs are the Harvest and Forecast
+	// accounts the user granted access to.
+	AccountIDs         []string
+	ForecastAccountIDs []string
+}
+
+// Login runs the OAuth2 authorization code flow with PKCE against Harvest
+// ID for command-line tools: it opens the user's browser on the
+// authorization page, waits for Harvest ID to redirect back to a
+// temporary server on RedirectURL, and exchanges the code for a token.
+// Cancel ctx to stop waiting. Store the token with SaveToken and refresh it
+// with HarvestIDRefresher.
+//
+// Experimental: this API may change in minor releases.
+func Login(ctx context.Context, opts LoginOptions) (*LoginResult, error) {
+	if opts.ClientID == "" {
+		return nil, errors.New("harvest: ClientID is required")
+	}
+	redirect, err := url.Parse(opts.RedirectURL)
+	if err != nil {
+		return nil, fmt.Errorf("harvest: invalid RedirectURL: %w", err)
+	}
+	if redirect.Scheme != "http" || (redirect.Hostname() != "localhost" && redirect.Hostname() != "127.0.0.1") || redirect.Port() == "" {
+		return nil, fmt.Errorf("harvest: RedirectURL %q must be http on localhost with a port", opts.RedirectURL)
+	}
+	openBrowser := opts.OpenBrowser
+	if openBrowser == nil {
+		openBrowser = openURL
+	}
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	state := randomString()
+	verifier := randomString()
+	challenge := sha256.Sum256([]byte(verifier))
+
+	listener, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	type callback struct {
+		query url.Values
+		err   error
+	}
+	callbacks := make(chan callback, 1)
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var cb callback
+		switch {
+		case q.Get("state") != state:
+			cb.err = errors.New("harvest: login redirect has the wrong state")
+		case q.Get("error") != "":
+			cb.err = fmt.Errorf("harvest: authorization denied: %s %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "":
+			cb.err = errors.New("harvest: login redirect has no code")
+		default:
+			cb.query = q
+		}
+		if cb.err != nil {
+			http.Error(w, cb.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Signed in to Harvest. You can close this window.")
+		}
+		select {
+		case callbacks <- cb:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	defer server.Close()
+
+	authURL := harvestIDAuthorizeURL + "?" + url.Values{
+		"client_id":             {opts.ClientID},
+		"response_type":         {"code"},
+		"redirect_uri":          {opts.RedirectURL},
+		"state":                 {state},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+	if err := openBrowser(authURL); err != nil {
+		return nil, fmt.Errorf("harvest: opening browser: %w", err)
+	}
+
+	var cb callback
+	select {
+	case cb = <-callbacks:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if cb.err != nil {
+		return nil, cb.err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {cb.query.Get("code")},
+		"client_id":     {opts.ClientID},
+		"redirect_uri":  {opts.RedirectURL},
+		"code_verifier": {verifier},
+	}
+	if opts.ClientSecret != "" {
+		form.Set("client_secret", opts.ClientSecret)
+	}
+	token, err := requestToken(ctx, httpClient, form)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LoginResult{Token: token}
+	for _, scope := range strings.Fields(cb.query.Get("scope")) {
+		if id, ok := strings.CutPrefix(scope, "harvest:"); ok {
+			result.AccountIDs = append(result.AccountIDs, id)
+		} else if id, ok := strings.CutPrefix(scope, "forecast:"); ok {
+			result.ForecastAccountIDs = append(result.ForecastAccountIDs, id)
+		}
+	}
+	return result, nil
+}
+
+// SaveToken writes token to path as JSON, readable only by the current
+// user, creating the parent directory if needed.
+//
+// Experimental: this API may change in minor releases.
+func SaveToken(path string, token *Token) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadToken reads a token written by SaveToken. It returns an error
+// wrapping fs.ErrNotExist when there is no saved token.
+//
+// Experimental: this API may change in minor releases.
+func LoadToken(path string) (*Token, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var token Token
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, fmt.Errorf("harvest: reading token from %s: %w", path, err)
+	}
+	return &token, nil
+}
+
+// SavingRefresher wraps refresh so that every refreshed token is also
+// written to path with SaveToken, keeping the stored refresh token current.
+//
+// Experimental: this API may change in minor releases.
+func SavingRefresher(refresh TokenRefresher, path string) TokenRefresher {
+	return func(ctx context.Context, refreshToken string) (*Token, error) {
+		token, err := refresh(ctx, refreshToken)
+		if err != nil {
+			return nil, err
+		}
+		saved := *token
+		if saved.RefreshToken == "" {
+			saved.RefreshToken = refreshToken
+		}
+		if err := SaveToken(path, &saved); err != nil {
+			return nil, fmt.Errorf("harvest: saving refreshed token: %w", err)
+		}
+		return token, nil
+	}
+}
+
+// randomString returns 32 random bytes encoded as unpadded base64url, as
+// used for the PKCE verifier and the state parameter.
+func randomString() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// openURL opens u in the user's default browser.
+func openURL(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	return cmd.Start()
+}
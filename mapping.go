@@ -0,0 +1,118 @@
+package harvest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+)
+
+// ErrNoMapping is returned by Mapper.Resolve when no rule or fallback matches an item.
+var ErrNoMapping = errors.New("harvest: no project/task mapping for item")
+
+// MappingConfig routes items from external systems, such as Jira project keys,
+// GitHub repositories, or calendar event titles, to Harvest projects and tasks.
+// Rules are evaluated in order and the first match wins; Fallback is used when
+// no rule matches. Load JSON configurations with ParseMappingConfig and YAML
+// ones with the mappingyaml package.
+//
+// Experimental: this API may change in minor releases.
+type MappingConfig struct {
+	Rules    []MappingRule  `json:"rules" yaml:"rules"`
+	Fallback *MappingTarget `json:"fallback,omitempty" yaml:"fallback,omitempty"`
+}
+
+// MappingRule maps items from a source whose key matches Match (a glob as
+// understood by path.Match) or Pattern (a regular expression).
+// An empty Source matches items from every source.
+type MappingRule struct {
+	Source    string    `json:"source,omitempty" yaml:"source,omitempty"`
+	Match     string    `json:"match,omitempty" yaml:"match,omitempty"`
+	Pattern   string    `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	ProjectID ProjectID `json:"project_id" yaml:"project_id"`
+	TaskID    TaskID    `json:"task_id" yaml:"task_id"`
+}
+
+// MappingTarget is the Harvest project and task an item is routed to.
+type MappingTarget struct {
+	ProjectID ProjectID `json:"project_id" yaml:"project_id"`
+	TaskID    TaskID    `json:"task_id" yaml:"task_id"`
+}
+
+// Mapper evaluates a MappingConfig.
+//
+// Experimental: this API may change in minor releases.
+type Mapper struct {
+	rules    []compiledRule
+	fallback *MappingTarget
+}
+
+type compiledRule struct {
+	MappingRule
+	re *regexp.Regexp
+}
+
+// ParseMappingConfig decodes a JSON mapping configuration and returns a Mapper for it.
+func ParseMappingConfig(data []byte) (*Mapper, error) {
+	var cfg MappingConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return NewMapper(cfg)
+}
+
+// NewMapper validates cfg and returns a Mapper for it.
+func NewMapper(cfg MappingConfig) (*Mapper, error) {
+	m := &Mapper{fallback: cfg.Fallback}
+
+	for i, rule := range cfg.Rules {
+		if (rule.Match == "") == (rule.Pattern == "") {
+			return nil, fmt.Errorf("mapping rule %d: exactly one of match or pattern is required", i)
+		}
+		if rule.ProjectID == 0 || rule.TaskID == 0 {
+			return nil, fmt.Errorf("mapping rule %d: project_id and task_id are required", i)
+		}
+
+		compiled := compiledRule{MappingRule: rule}
+		if rule.Pattern != "" {
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("mapping rule %d: %w", i, err)
+			}
+			compiled.re = re
+		} else if _, err := path.Match(rule.Match, ""); err != nil {
+			return nil, fmt.Errorf("mapping rule %d: %w", i, err)
+		}
+		m.rules = append(m.rules, compiled)
+	}
+
+	return m, nil
+}
+
+// Resolve returns the project and task for the item identified by source and
+// key, falling back to the configured fallback. It returns ErrNoMapping when
+// nothing matches.
+func (m *Mapper) Resolve(source, key string) (MappingTarget, error) {
+	for _, rule := range m.rules {
+		if rule.Source != "" && rule.Source != source {
+			continue
+		}
+		if rule.matches(key) {
+			return MappingTarget{ProjectID: rule.ProjectID, TaskID: rule.TaskID}, nil
+		}
+	}
+
+	if m.fallback != nil {
+		return *m.fallback, nil
+	}
+	return MappingTarget{}, fmt.Errorf("%w: %s %q", ErrNoMapping, source, key)
+}
+
+func (r compiledRule) matches(key string) bool {
+	if r.re != nil {
+		return r.re.MatchString(key)
+	}
+	ok, _ := path.Match(r.Match, key)
+	return ok
+}
@@ -0,0 +1,38 @@
+// Package mappingyaml loads harvest project/task mapping configurations
+// written in YAML. It lives outside the harvest package so that programs
+// reading JSON mappings don't depend on a YAML parser.
+//
+// A configuration routes Jira project keys to Harvest projects and tasks:
+//
+//	rules:
+//	  - source: jira
+//	    match: WEB-*
+//	    project_id: 14307913
+//	    task_id: 8083365
+//	fallback:
+//	  project_id: 14308069
+//	  task_id: 8083366
+//
+// Experimental: this package may change in minor releases.
+package mappingyaml
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/joefitzgerald/harvest"
+	"gopkg.in/yaml.v3"
+)
+
+// Parse decodes a YAML mapping configuration and returns a Mapper for it.
+// Unknown keys are rejected so that typos don't silently drop a rule.
+func Parse(data []byte) (*harvest.Mapper, error) {
+	var cfg harvest.MappingConfig
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return harvest.NewMapper(cfg)
+}
@@ -0,0 +1,64 @@
+package mappingyaml
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/joefitzgerald/harvest"
+)
+
+const config = `
+rules:
+  - source: jira
+    match: WEB-*
+    project_id: 14307913
+    task_id: 8083365
+  - pattern: ^acme/
+    project_id: 14308069
+    task_id: 8083366
+fallback:
+  project_id: 14808188
+  task_id: 8083367
+`
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		source, key string
+		want        harvest.MappingTarget
+	}{
+		{"jira", "WEB-12", harvest.MappingTarget{ProjectID: 14307913, TaskID: 8083365}},
+		{"github", "acme/site", harvest.MappingTarget{ProjectID: 14308069, TaskID: 8083366}},
+		{"calendar", "Standup", harvest.MappingTarget{ProjectID: 14808188, TaskID: 8083367}},
+	}
+	for _, tt := range tests {
+		got, err := m.Resolve(tt.source, tt.key)
+		if err != nil {
+			t.Errorf("Resolve(%q, %q): %v", tt.source, tt.key, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Resolve(%q, %q) = %+v, want %+v", tt.source, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	m, err := Parse(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Resolve("jira", "WEB-1"); !errors.Is(err, harvest.ErrNoMapping) {
+		t.Errorf("Resolve on empty config: %v, want ErrNoMapping", err)
+	}
+}
+
+func TestParseUnknownKey(t *testing.T) {
+	if _, err := Parse([]byte("rules:\n  - match: WEB-*\n    projet_id: 1\n    task_id: 2\n")); err == nil {
+		t.Error("Parse accepted a misspelled key")
+	}
+}
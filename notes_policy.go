@@ -0,0 +1,73 @@
+package harvest
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// NotesPolicy checks the notes of a time entry about to be created on a
+// project. It returns nil when the notes are acceptable.
+type NotesPolicy func(projectID ProjectID, notes string) *NotesViolation
+
+// NotesViolation describes why a time entry's notes were rejected by a NotesPolicy.
+type NotesViolation struct {
+	ProjectID ProjectID `json:"project_id"`
+	Notes     string    `json:"notes"`
+	Reason    string    `json:"reason"`
+}
+
+// NotesPolicyError is returned when time entry notes violate the client's NotesPolicy.
+type NotesPolicyError struct {
+	Violations []NotesViolation
+}
+
+func (e *NotesPolicyError) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		reasons[i] = fmt.Sprintf("project %d: %s", v.ProjectID, v.Reason)
+	}
+	return "notes policy violation: " + strings.Join(reasons, "; ")
+}
+
+// RequireNotesMatch returns a NotesPolicy requiring notes to match re on the
+// given projects, or on every project when none are given. For example, to
+// require a Jira ticket reference:
+//
+//	harvest.RequireNotesMatch(regexp.MustCompile(`\b[A-Z]+-\d+\b`), 14307913)
+func RequireNotesMatch(re *regexp.Regexp, projectIDs ...ProjectID) NotesPolicy {
+	return func(projectID ProjectID, notes string) *NotesViolation {
+		if len(projectIDs) > 0 && !slices.Contains(projectIDs, projectID) {
+			return nil
+		}
+		if re.MatchString(notes) {
+			return nil
+		}
+		return &NotesViolation{
+			ProjectID: projectID,
+			Notes:     notes,
+			Reason:    fmt.Sprintf("notes must match %s", re),
+		}
+	}
+}
+
+// WithNotesPolicy evaluates policy before time entries are created and
+// rejects entries that violate it with a *NotesPolicyError.
+func WithNotesPolicy(policy NotesPolicy) Option {
+	return func(c *API) {
+		c.notesPolicy = policy
+	}
+}
+
+// CheckNotes evaluates the client's NotesPolicy against notes for a time entry
+// on projectID. It returns nil when no policy is configured.
+func (c *API) CheckNotes(projectID ProjectID, notes string) error {
+	if c.notesPolicy == nil {
+		return nil
+	}
+	if v := c.notesPolicy(projectID, notes); v != nil {
+		return &NotesPolicyError{Violations: []NotesViolation{*v}}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package harvest
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable is an optional request field that can also be set to null,
+// which is how Harvest clears a value. The zero Nullable is unset and is
+// left out of the request by the omitzero tag option.
+//
+//	harvest.ProjectUpdateRequest{
+//		EndsOn: harvest.Null[string](), // clear the end date
+//		Budget: harvest.Set(decimal.NewFromInt(500)), // change the budget
+//	}
+type Nullable[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Set returns a Nullable holding v.
+func Set[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, set: true}
+}
+
+// Null returns a Nullable that is sent as null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{set: true, null: true}
+}
+
+// Get returns the value and whether one is set. It returns false for both
+// unset and null fields.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.set && !n.null
+}
+
+// Value returns the value, or the zero value if the field is unset or null.
+func (n Nullable[T]) Value() T {
+	return n.value
+}
+
+// IsNull reports whether the field is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	return n.null
+}
+
+// IsZero reports whether the field is unset, so omitzero leaves it out.
+func (n Nullable[T]) IsZero() bool {
+	return !n.set
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.set || n.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = Set(v)
+	return nil
+}
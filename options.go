@@ -0,0 +1,111 @@
+package harvest
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures optional behavior of an API client.
+type Option func(*API)
+
+// WithDebug dumps every request and response, including headers and bodies,
+// to w. The Authorization and Harvest-Account-Id headers are redacted.
+func WithDebug(w io.Writer) Option {
+	return func(c *API) {
+		c.debug = w
+	}
+}
+
+// WithBaseURL sends requests to u instead of the Harvest API, for example to
+// run a program against a fake server in tests.
+func WithBaseURL(u *url.URL) Option {
+	return func(c *API) {
+		base := *u
+		if !strings.HasSuffix(base.Path, "/") {
+			base.Path += "/"
+		}
+		c.baseURL = &base
+	}
+}
+
+// WithUserAgent replaces the User-Agent passed to New or NewWithConfig, for
+// white-label tools that build clients on behalf of another application.
+// Suffixes added with WithUserAgentSuffix are still appended.
+func WithUserAgent(userAgent string) Option {
+	return func(c *API) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithUserAgentSuffix appends an identifier to the User-Agent, such as
+// "MyLib/1.2", so libraries built on this package can identify themselves
+// alongside the application as Harvest recommends. It may be used more than
+// once; suffixes are appended in order.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(c *API) {
+		c.uaSuffixes = append(c.uaSuffixes, suffix)
+	}
+}
+
+// WithDefaultTimeout sets the timeout applied to each API call that doesn't
+// carry its own timeout from WithTimeout. A zero duration disables it.
+// The default is 30 seconds.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *API) {
+		c.timeout = d
+	}
+}
+
+type timeoutKey struct{}
+
+// WithTimeout returns a copy of ctx that makes API calls made with it use
+// timeout d instead of the client's default, so slow report endpoints can be
+// given longer deadlines without affecting other calls. A zero duration
+// disables the timeout for those calls. Deadlines already on ctx still apply.
+func WithTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, d)
+}
+
+// WithLogger sets the logger used for warnings such as calls to deprecated
+// methods. By default nothing is logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *API) {
+		c.logger = logger
+	}
+}
+
+// WithPageTimeout gives each page fetched by the List methods its own
+// deadline of d instead of the client's default timeout, retrying a page up
+// to retries more times when that deadline expires. This keeps long exports
+// going when a single page is slow; the deadline on the caller's context
+// still bounds the listing as a whole.
+func WithPageTimeout(d time.Duration, retries int) Option {
+	return func(c *API) {
+		c.pageTimeout = d
+		c.pageRetries = retries
+	}
+}
+
+// WithPrefetch makes the List, All and Stream methods fetch the remaining
+// pages of page-based listings with up to workers concurrent requests once
+// the first page reports the total page count. Items are still returned in
+// order. Pages rejected by the rate limiter are retried after the limit
+// resets. Cursor-based listings are always fetched one page at a time.
+func WithPrefetch(workers int) Option {
+	return func(c *API) {
+		c.prefetch = workers
+	}
+}
+
+// WithExtraFields makes the client keep response fields that the resource
+// structs don't model in their Extra fields. Capturing them costs a second
+// pass over each response, so it is off by default.
+func WithExtraFields() Option {
+	return func(c *API) {
+		c.extraFields = true
+	}
+}
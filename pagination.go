@@ -1,8 +1,11 @@
 package harvest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"iter"
 	"net/http"
 	"strconv"
 	"time"
@@ -10,12 +13,39 @@ import (
 
 // ListOptions specifies optional parameters to List methods.
 type ListOptions struct {
-	Page         int        `url:"page,omitempty"`
-	PerPage      int        `url:"per_page,omitempty"`
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+	// UpdatedSince limits results to records updated after the given time.
+	// It is sent in RFC 3339 format.
 	UpdatedSince *time.Time `url:"updated_since,omitempty"`
+
+	// MaxPages and MaxItems cap how much the List, All and Stream methods
+	// fetch. Zero means no limit. They are not sent to the API.
+	MaxPages int `url:"-"`
+	MaxItems int `url:"-"`
+
+	// OnPage, if set, is called after each page is fetched by the List,
+	// All and Stream methods, e.g. to drive a progress bar.
+	OnPage func(PageInfo) `url:"-"`
+}
+
+// PageInfo describes progress through a multi-page listing.
+type PageInfo struct {
+	// Page is the page just fetched. TotalPages and TotalEntries are as
+	// reported by the API and may be zero for cursor-based endpoints.
+	Page         int
+	TotalPages   int
+	TotalEntries int
+	// Pages and Items count what has been fetched so far, including this page.
+	Pages int
+	Items int
+	// Rate is the most recent rate limit reported by the API.
+	Rate Rate
 }
 
-// Paginated represents a paginated response from the Harvest API.
+// Paginated represents a paginated response from the Harvest API. Items
+// holds the resources from the response's array field, whatever its key
+// (e.g. "time_entries"); see UnmarshalJSON.
 type Paginated[T any] struct {
 	Items        []T              `json:"-"`
 	Links        *PaginationLinks `json:"links"`
@@ -25,9 +55,76 @@ type Paginated[T any] struct {
 	NextPage     *int             `json:"next_page"`
 	PreviousPage *int             `json:"previous_page"`
 	Page         int              `json:"page"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Harvest wraps each page in an
+// object with an array field named after the resource, alongside the
+// pagination metadata. A bare Paginated doesn't know that name, so Items is
+// decoded from the first top-level field, in document order, that holds an
+// array.
+//
+// The list types that embed Paginated, such as ProjectList, define their own
+// UnmarshalJSON that decodes their named field instead.
+func (p *Paginated[T]) UnmarshalJSON(data []byte) error {
+	raw, err := pageItems(data)
+	if err != nil {
+		return err
+	}
+	return unmarshalPage(data, raw, p, nil)
+}
 
-	// The actual items will be in a field named after the resource type
-	// We'll handle this with custom unmarshaling or in resource-specific methods
+// unmarshalPageKey decodes a page whose items are in the field key into p
+// and items, for the UnmarshalJSON methods of the list types.
+func unmarshalPageKey[T any](data []byte, key string, p *Paginated[T], items *[]T) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	return unmarshalPage(data, fields[key], p, items)
+}
+
+// unmarshalPage decodes the pagination metadata in data and the items in raw
+// into p, also setting *items when items is not nil.
+func unmarshalPage[T any](data, raw []byte, p *Paginated[T], items *[]T) error {
+	type paginated Paginated[T]
+	var meta paginated
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return err
+	}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &meta.Items); err != nil {
+			return err
+		}
+	}
+	*p = Paginated[T](meta)
+	if items != nil {
+		*items = p.Items
+	}
+	return nil
+}
+
+// pageItems returns the first top-level field of the JSON object data, in
+// document order, that holds an array, or nil if there is none.
+func pageItems(data []byte) (json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('{') {
+		return nil, errors.New("harvest: page is not a JSON object")
+	}
+	for dec.More() {
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		if len(raw) > 0 && raw[0] == '[' {
+			return raw, nil
+		}
+	}
+	return nil, nil
 }
 
 // PaginationLinks represents pagination links in API responses.
@@ -66,6 +163,298 @@ func (p *Paginated[T]) HasPreviousPage() bool {
 	return p.PreviousPage != nil
 }
 
+func (p *Paginated[T]) page() *Paginated[T] {
+	return p
+}
+
+// listAll collects the items from every page of a list endpoint.
+func listAll[T any, L any, PL interface {
+	*L
+	page() *Paginated[T]
+}](ctx context.Context, c *API, opts ListOptions, fetch func(ctx context.Context, page int) (PL, error)) ([]T, error) {
+	return collect(all[T](ctx, c, opts, fetch))
+}
+
+// all returns an iterator over the items of a list endpoint, starting with
+// the page fetched by fetch(opts.Page). Later pages follow the cursor URL
+// from the response links when present and are otherwise requested by
+// number. Pages are only requested as the caller consumes
+// items, unless the client prefetches, and iteration stops after the first
+// error or once opts.MaxPages or opts.MaxItems is reached.
+func all[T any, L any, PL interface {
+	*L
+	page() *Paginated[T]
+}](ctx context.Context, c *API, opts ListOptions, fetch func(ctx context.Context, page int) (PL, error)) iter.Seq2[T, error] {
+	startPage := opts.Page
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var zero T
+		var prefetched []chan pageResult[PL]
+		var pages, items, fetched int
+		next := func(ctx context.Context) (PL, error) {
+			return fetch(ctx, startPage)
+		}
+		for {
+			var result PL
+			var err error
+			if len(prefetched) > 0 {
+				select {
+				case r := <-prefetched[0]:
+					result, err = r.page, r.err
+				case <-ctx.Done():
+					err = ctx.Err()
+				}
+				prefetched = prefetched[1:]
+			} else {
+				result, err = fetchPage(ctx, c, next)
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page := result.page()
+			pages++
+			fetched += len(page.Items)
+			if opts.OnPage != nil {
+				opts.OnPage(PageInfo{
+					Page:         page.Page,
+					TotalPages:   page.TotalPages,
+					TotalEntries: page.TotalEntries,
+					Pages:        pages,
+					Items:        fetched,
+					Rate:         c.usage.lastRate(),
+				})
+			}
+			if c.prefetch > 1 && startPage > 0 && prefetched == nil && page.NextPage != nil && page.TotalPages >= *page.NextPage {
+				// The remaining page numbers are known, so fetch them
+				// concurrently while the caller works through this one.
+				// Cursor-based listings start without a page number and
+				// are never prefetched.
+				last := page.TotalPages
+				if opts.MaxPages > 0 {
+					last = min(last, startPage+opts.MaxPages-1)
+				}
+				if opts.MaxItems > 0 && page.PerPage > 0 {
+					last = min(last, startPage+(opts.MaxItems+page.PerPage-1)/page.PerPage-1)
+				}
+				if last >= *page.NextPage {
+					prefetched = prefetchPages(ctx, c, *page.NextPage, last, fetch)
+				}
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+				items++
+				if opts.MaxItems > 0 && items >= opts.MaxItems {
+					return
+				}
+			}
+
+			if opts.MaxPages > 0 && pages >= opts.MaxPages {
+				return
+			}
+			if len(prefetched) > 0 {
+				continue
+			}
+			if !page.HasNextPage() {
+				return
+			}
+			if nextURL := page.GetNextPageURL(); nextURL != "" {
+				// Use cursor-based pagination (follow the Links.Next URL)
+				next = func(ctx context.Context) (PL, error) {
+					return getFromURL[L](ctx, c, nextURL)
+				}
+			} else {
+				// Use page-based pagination
+				pageNum := *page.NextPage
+				next = func(ctx context.Context) (PL, error) {
+					return fetch(ctx, pageNum)
+				}
+			}
+		}
+	}
+}
+
+// pageResult is the outcome of fetching one prefetched page.
+type pageResult[PL any] struct {
+	page PL
+	err  error
+}
+
+// prefetchPages fetches pages from through to using c.prefetch workers and
+// returns one buffered channel per page, in page order. Workers stop taking
+// new pages once ctx is done.
+func prefetchPages[PL any](ctx context.Context, c *API, from, to int, fetch func(ctx context.Context, page int) (PL, error)) []chan pageResult[PL] {
+	results := make([]chan pageResult[PL], to-from+1)
+	for i := range results {
+		results[i] = make(chan pageResult[PL], 1)
+	}
+
+	pages := make(chan int)
+	go func() {
+		defer close(pages)
+		for n := from; n <= to; n++ {
+			select {
+			case pages <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for range c.prefetch {
+		go func() {
+			for n := range pages {
+				result, err := fetchRateLimited(ctx, c, func(ctx context.Context) (PL, error) {
+					return fetch(ctx, n)
+				})
+				results[n-from] <- pageResult[PL]{page: result, err: err}
+			}
+		}()
+	}
+
+	return results
+}
+
+// maxRateLimitRetries bounds how often a prefetched page is retried after
+// the API reports the rate limit was exceeded.
+const maxRateLimitRetries = 3
+
+// fetchRateLimited calls fetchPage, waiting out the rate limit window and
+// trying again when the API responds with 429 Too Many Requests. Concurrent
+// prefetching is the most likely way to hit the limit, so prefetched pages
+// back off rather than failing the whole listing.
+func fetchRateLimited[P any](ctx context.Context, c *API, fetch func(context.Context) (P, error)) (P, error) {
+	for attempt := 0; ; attempt++ {
+		result, err := fetchPage(ctx, c, fetch)
+		var rateErr *RateLimitError
+		if !errors.As(err, &rateErr) || attempt >= maxRateLimitRetries {
+			return result, err
+		}
+
+		timer := time.NewTimer(retryAfter(rateErr))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		}
+	}
+}
+
+// retryAfter returns how long to wait before retrying a rate limited
+// request, from the Retry-After header or the rate limit reset time.
+func retryAfter(e *RateLimitError) time.Duration {
+	if e.Response != nil {
+		if secs, err := strconv.Atoi(e.Response.Header.Get("Retry-After")); err == nil && secs > 0 {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	if d := time.Until(e.Rate.Reset.Time); d > 0 {
+		return d
+	}
+	return time.Second
+}
+
+// collect gathers every item yielded by seq, returning the first error.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ListResult is the outcome of a ListWithTotals call.
+type ListResult[T any] struct {
+	Items []T
+	// TotalEntries and TotalPages are as reported by the API on the last
+	// page fetched, so they cover the whole listing even when MaxPages or
+	// MaxItems stopped it early.
+	TotalEntries int
+	TotalPages   int
+	// Rate is the rate limit reported with the last page.
+	Rate Rate
+}
+
+// collectWithTotals collects the items of the sequence returned by seq
+// while recording the totals from each page. Any OnPage callback in opts is
+// still called.
+func collectWithTotals[T any](opts *ListOptions, seq func() iter.Seq2[T, error]) (*ListResult[T], error) {
+	result := &ListResult[T]{}
+	onPage := opts.OnPage
+	opts.OnPage = func(info PageInfo) {
+		result.TotalEntries = info.TotalEntries
+		result.TotalPages = info.TotalPages
+		result.Rate = info.Rate
+		if onPage != nil {
+			onPage(info)
+		}
+	}
+	items := seq()
+	opts.OnPage = onPage
+
+	var err error
+	result.Items, err = collect(items)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// stream sends the items of seq on a channel buffered to size from a
+// background goroutine. The error channel is buffered so the goroutine never
+// blocks on it, and both channels are closed when seq ends or ctx is done.
+func stream[T any](ctx context.Context, seq iter.Seq2[T, error], size int) (<-chan T, <-chan error) {
+	items := make(chan T, size)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(items)
+		defer close(errc)
+
+		for item, err := range seq {
+			if err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case items <- item:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return items, errc
+}
+
+// fetchPage calls fetch for a single page of a multi-page listing. When the
+// client has a page timeout, each attempt gets its own deadline and a page
+// whose deadline expires is retried, so one slow page doesn't need the whole
+// listing to be restarted. The deadline of ctx itself is never extended.
+func fetchPage[P any](ctx context.Context, c *API, fetch func(context.Context) (P, error)) (P, error) {
+	if c.pageTimeout <= 0 {
+		return fetch(ctx)
+	}
+
+	for attempt := 0; ; attempt++ {
+		result, err := fetch(WithTimeout(ctx, c.pageTimeout))
+		if err == nil || !errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil || attempt >= c.pageRetries {
+			return result, err
+		}
+	}
+}
+
 // Iterator provides iteration over paginated results.
 type Iterator[T any] struct {
 	client  *API
@@ -0,0 +1,48 @@
+package harvest
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListUnmarshalFillsNamedField(t *testing.T) {
+	data := []byte(`{"projects": [{"id": 14307913, "name": "Marketing Website"}], "page": 1, "total_pages": 3, "next_page": 2}`)
+	var list ProjectList
+	if err := json.Unmarshal(data, &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Projects) != 1 || list.Projects[0].ID != 14307913 {
+		t.Errorf("Projects = %+v, want the listed project", list.Projects)
+	}
+	if len(list.Items) != 1 {
+		t.Errorf("Items has %d entries, want 1", len(list.Items))
+	}
+	if list.TotalPages != 3 || list.NextPage == nil || *list.NextPage != 2 {
+		t.Errorf("metadata = %+v, want page 1 of 3", list.Paginated)
+	}
+}
+
+func TestListUnmarshalUsesItsKey(t *testing.T) {
+	// The teammates array must be used even when another array comes first.
+	data := []byte(`{"warnings": [], "teammates": [{"id": 1782959, "first_name": "Kim"}], "page": 1}`)
+	var list UserTeammateList
+	if err := json.Unmarshal(data, &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Teammates) != 1 || list.Teammates[0].ID != 1782959 {
+		t.Errorf("Teammates = %+v, want the listed teammate", list.Teammates)
+	}
+}
+
+func TestPaginatedUnmarshalFirstArray(t *testing.T) {
+	data := []byte(`{"page": 1, "clients": [{"id": 5735776}], "extra": [{"id": 1}, {"id": 2}]}`)
+	for range 20 {
+		var p Paginated[Client]
+		if err := json.Unmarshal(data, &p); err != nil {
+			t.Fatal(err)
+		}
+		if len(p.Items) != 1 || p.Items[0].ID != 5735776 {
+			t.Fatalf("Items = %+v, want the first array in the document", p.Items)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package harvest
+
+// Ptr returns a pointer to v.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Bool returns a pointer to b.
+func Bool(b bool) *bool {
+	return &b
+}
+
+// Int64 returns a pointer to i.
+func Int64(i int64) *int64 {
+	return &i
+}
+
+// String returns a pointer to s.
+func String(s string) *string {
+	return &s
+}
+
+// Float64 returns a pointer to f.
+func Float64(f float64) *float64 {
+	return &f
+}
+
+// valueOf returns the value p points to, or the zero value when p is nil.
+func valueOf[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
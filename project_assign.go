@@ -0,0 +1,136 @@
+package harvest
+
+import "context"
+
+// UserAssignmentOutcome is the result of assigning one user with
+// AssignUsers.
+type UserAssignmentOutcome struct {
+	UserID     UserID
+	Assignment *ProjectUserAssignment
+	// Existing is true when the user was already assigned and Assignment is
+	// the assignment found.
+	Existing bool
+	Err      error
+}
+
+// AssignUsers assigns users to a project, creating the assignments
+// concurrently with the settings in defaults, and returns one outcome per
+// user in input order. Users who are already assigned, including through
+// inactive assignments, are skipped and their existing assignment
+// returned. A failed assignment does not stop the others; requests pause
+// and retry on rate limits like BulkCreate. The error is only set when the
+// project's current assignments can't be listed.
+//
+// Experimental: this API may change in minor releases.
+func (s *ProjectsService) AssignUsers(ctx context.Context, projectID ProjectID, userIDs []UserID, defaults UserAssignmentCreateRequest) ([]UserAssignmentOutcome, error) {
+	current, err := s.ListUserAssignments(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[UserID]*ProjectUserAssignment, len(current))
+	for i := range current {
+		if current[i].User != nil {
+			existing[current[i].User.ID] = &current[i]
+		}
+	}
+
+	// pending maps each user to be assigned to its create request, so that
+	// a user listed twice is only assigned once.
+	pending := make(map[UserID]int)
+	var missing []UserAssignmentCreateRequest
+	for _, id := range userIDs {
+		if _, ok := existing[id]; ok {
+			continue
+		}
+		if _, ok := pending[id]; ok {
+			continue
+		}
+		req := defaults
+		req.UserID = id
+		pending[id] = len(missing)
+		missing = append(missing, req)
+	}
+
+	results := bulk(ctx, s.client, missing, nil, func(ctx context.Context, req *UserAssignmentCreateRequest) (*ProjectUserAssignment, error) {
+		return s.CreateUserAssignment(ctx, projectID, req)
+	})
+
+	outcomes := make([]UserAssignmentOutcome, len(userIDs))
+	for i, id := range userIDs {
+		if a, ok := existing[id]; ok {
+			outcomes[i] = UserAssignmentOutcome{UserID: id, Assignment: a, Existing: true}
+			continue
+		}
+		r := results[pending[id]]
+		outcomes[i] = UserAssignmentOutcome{UserID: id, Assignment: r.Item, Err: r.Err}
+	}
+	return outcomes, nil
+}
+
+// TaskAssignmentOutcome is the result of assigning one task with
+// AssignAllTasks.
+type TaskAssignmentOutcome struct {
+	TaskID     TaskID
+	Assignment *ProjectTaskAssignment
+	// Existing is true when the task was already assigned and Assignment is
+	// the assignment found.
+	Existing bool
+	Err      error
+}
+
+// AssignAllTasks makes sure every active task on the account is assigned
+// to a project, like the "add all tasks" button in Harvest. Missing
+// assignments are created concurrently with the settings in defaults;
+// when defaults leaves Billable unset, each task's BillableByDefault is
+// used. Tasks already assigned, including through inactive assignments,
+// are left as they are. One outcome is returned per active task, ordered
+// as the tasks are listed. The error is only set when the tasks or the
+// project's current assignments can't be listed.
+//
+// Experimental: this API may change in minor releases.
+func (s *ProjectsService) AssignAllTasks(ctx context.Context, projectID ProjectID, defaults TaskAssignmentCreateRequest) ([]TaskAssignmentOutcome, error) {
+	tasks, err := s.client.Tasks.List(ctx, &TaskListOptions{IsActive: Bool(true)})
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.ListTaskAssignments(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[TaskID]*ProjectTaskAssignment, len(current))
+	for i := range current {
+		if current[i].Task != nil {
+			existing[current[i].Task.ID] = &current[i]
+		}
+	}
+
+	pending := make(map[TaskID]int)
+	var missing []TaskAssignmentCreateRequest
+	for _, t := range tasks {
+		if _, ok := existing[t.ID]; ok {
+			continue
+		}
+		req := defaults
+		req.TaskID = t.ID
+		if req.Billable == nil {
+			req.Billable = Bool(t.BillableByDefault)
+		}
+		pending[t.ID] = len(missing)
+		missing = append(missing, req)
+	}
+
+	results := bulk(ctx, s.client, missing, nil, func(ctx context.Context, req *TaskAssignmentCreateRequest) (*ProjectTaskAssignment, error) {
+		return s.CreateTaskAssignment(ctx, projectID, req)
+	})
+
+	outcomes := make([]TaskAssignmentOutcome, len(tasks))
+	for i, t := range tasks {
+		if a, ok := existing[t.ID]; ok {
+			outcomes[i] = TaskAssignmentOutcome{TaskID: t.ID, Assignment: a, Existing: true}
+			continue
+		}
+		r := results[pending[t.ID]]
+		outcomes[i] = TaskAssignmentOutcome{TaskID: t.ID, Assignment: r.Item, Err: r.Err}
+	}
+	return outcomes, nil
+}
@@ -0,0 +1,205 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProjectCloneOverrides sets what differs between a project and its clone.
+// Empty fields keep the source project's values, except Code, which is
+// left empty unless set since a code usually identifies one engagement.
+type ProjectCloneOverrides struct {
+	Name     string
+	Code     string
+	ClientID ClientID
+	StartsOn *Date
+	EndsOn   *Date
+	// IncludeInactive also copies inactive task and user assignments.
+	IncludeInactive bool
+}
+
+// Clone creates a new project with the settings, task assignments and user
+// assignments of sourceProjectID, applying overrides. Harvest adds default
+// tasks and the creating user to new projects on its own; those
+// assignments are updated to match the source rather than duplicated.
+// Requests pause and retry on rate limits like BulkCreate. If copying an
+// assignment fails, the new project is returned with the error so it can
+// be finished or deleted.
+//
+// Experimental: this API may change in minor releases.
+func (s *ProjectsService) Clone(ctx context.Context, sourceProjectID ProjectID, overrides ProjectCloneOverrides) (*Project, error) {
+	o := BulkOptions{Concurrency: 1, MaxRetries: 3}
+	call := func(do func(context.Context) (*Project, error)) (*Project, error) {
+		return bulkCall(ctx, s.client, o, do)
+	}
+
+	src, err := call(func(ctx context.Context) (*Project, error) { return s.Get(ctx, sourceProjectID) })
+	if err != nil {
+		return nil, err
+	}
+
+	req := &ProjectCreateRequest{
+		Name:                             src.Name,
+		Code:                             overrides.Code,
+		IsActive:                         Bool(true),
+		IsBillable:                       Bool(src.IsBillable),
+		IsFixedFee:                       Bool(src.IsFixedFee),
+		BillBy:                           src.BillBy,
+		Budget:                           src.Budget,
+		BudgetBy:                         src.BudgetBy,
+		BudgetIsMonthly:                  Bool(src.BudgetIsMonthly),
+		NotifyWhenOverBudget:             Bool(src.NotifyWhenOverBudget),
+		OverBudgetNotificationPercentage: Ptr(src.OverBudgetNotificationPercentage),
+		ShowBudgetToAll:                  Bool(src.ShowBudgetToAll),
+		CostBudget:                       src.CostBudget,
+		CostBudgetIncludeExpenses:        Bool(src.CostBudgetIncludeExpenses),
+		HourlyRate:                       src.HourlyRate,
+		Fee:                              src.Fee,
+		Notes:                            src.Notes,
+	}
+	if src.Client != nil {
+		req.ClientID = src.Client.ID
+	}
+	if overrides.Name != "" {
+		req.Name = overrides.Name
+	}
+	if overrides.ClientID != 0 {
+		req.ClientID = overrides.ClientID
+	}
+	req.StartsOn = optionalDate(src.StartsOn, overrides.StartsOn)
+	req.EndsOn = optionalDate(src.EndsOn, overrides.EndsOn)
+
+	project, err := call(func(ctx context.Context) (*Project, error) { return s.Create(ctx, req) })
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cloneTaskAssignments(ctx, o, src.ID, project.ID, overrides.IncludeInactive); err != nil {
+		return project, err
+	}
+	if err := s.cloneUserAssignments(ctx, o, src.ID, project.ID, overrides.IncludeInactive); err != nil {
+		return project, err
+	}
+	return project, nil
+}
+
+// cloneTaskAssignments copies the task assignments of src to dst.
+func (s *ProjectsService) cloneTaskAssignments(ctx context.Context, o BulkOptions, src, dst ProjectID, includeInactive bool) error {
+	var opts *TaskAssignmentListOptions
+	if !includeInactive {
+		opts = &TaskAssignmentListOptions{IsActive: Bool(true)}
+	}
+	source, err := s.ListTaskAssignments(ctx, src, opts)
+	if err != nil {
+		return err
+	}
+	current, err := s.ListTaskAssignments(ctx, dst, nil)
+	if err != nil {
+		return err
+	}
+	existing := make(map[TaskID]TaskAssignmentID, len(current))
+	for _, a := range current {
+		if a.Task != nil {
+			existing[a.Task.ID] = a.ID
+		}
+	}
+
+	for _, a := range source {
+		if a.Task == nil {
+			continue
+		}
+		_, err := bulkCall(ctx, s.client, o, func(ctx context.Context) (*ProjectTaskAssignment, error) {
+			if id, ok := existing[a.Task.ID]; ok {
+				return s.UpdateTaskAssignment(ctx, dst, id, &TaskAssignmentUpdateRequest{
+					IsActive:   Bool(a.IsActive),
+					Billable:   Bool(a.Billable),
+					HourlyRate: nullableDecimal(a.HourlyRate),
+					Budget:     nullableDecimal(a.Budget),
+				})
+			}
+			return s.CreateTaskAssignment(ctx, dst, &TaskAssignmentCreateRequest{
+				TaskID:     a.Task.ID,
+				IsActive:   Bool(a.IsActive),
+				Billable:   Bool(a.Billable),
+				HourlyRate: a.HourlyRate,
+				Budget:     a.Budget,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("harvest: copying task %q: %w", a.Task.Name, err)
+		}
+	}
+	return nil
+}
+
+// cloneUserAssignments copies the user assignments of src to dst.
+func (s *ProjectsService) cloneUserAssignments(ctx context.Context, o BulkOptions, src, dst ProjectID, includeInactive bool) error {
+	var opts *UserAssignmentListOptions
+	if !includeInactive {
+		opts = &UserAssignmentListOptions{IsActive: Bool(true)}
+	}
+	source, err := s.ListUserAssignments(ctx, src, opts)
+	if err != nil {
+		return err
+	}
+	current, err := s.ListUserAssignments(ctx, dst, nil)
+	if err != nil {
+		return err
+	}
+	existing := make(map[UserID]UserAssignmentID, len(current))
+	for _, a := range current {
+		if a.User != nil {
+			existing[a.User.ID] = a.ID
+		}
+	}
+
+	for _, a := range source {
+		if a.User == nil {
+			continue
+		}
+		_, err := bulkCall(ctx, s.client, o, func(ctx context.Context) (*ProjectUserAssignment, error) {
+			if id, ok := existing[a.User.ID]; ok {
+				return s.UpdateUserAssignment(ctx, dst, id, &UserAssignmentUpdateRequest{
+					IsActive:         Bool(a.IsActive),
+					IsProjectManager: Bool(a.IsProjectManager),
+					UseDefaultRates:  Bool(a.UseDefaultRates),
+					HourlyRate:       nullableDecimal(a.HourlyRate),
+					Budget:           nullableDecimal(a.Budget),
+				})
+			}
+			return s.CreateUserAssignment(ctx, dst, &UserAssignmentCreateRequest{
+				UserID:           a.User.ID,
+				IsActive:         Bool(a.IsActive),
+				IsProjectManager: Bool(a.IsProjectManager),
+				UseDefaultRates:  Bool(a.UseDefaultRates),
+				HourlyRate:       a.HourlyRate,
+				Budget:           a.Budget,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("harvest: copying user %d: %w", a.User.ID, err)
+		}
+	}
+	return nil
+}
+
+// optionalDate formats override, or src when override is nil, for a
+// request.
+func optionalDate(src, override *Date) string {
+	if override != nil {
+		src = override
+	}
+	if src == nil {
+		return ""
+	}
+	return dateString(*src)
+}
+
+// nullableDecimal sets an update field to v, or clears it when v is nil.
+func nullableDecimal(v *decimal.Decimal) Nullable[decimal.Decimal] {
+	if v == nil {
+		return Null[decimal.Decimal]()
+	}
+	return Set(*v)
+}
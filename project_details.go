@@ -0,0 +1,58 @@
+package harvest
+
+import (
+	"context"
+	"sync"
+)
+
+// ProjectDetails is a project together with its user and task
+// assignments.
+type ProjectDetails struct {
+	Project         *Project
+	UserAssignments []ProjectUserAssignment
+	TaskAssignments []ProjectTaskAssignment
+}
+
+// GetWithAssignments retrieves a project and all of its user and task
+// assignments, fetching the three in parallel. If a request fails, the
+// others are cancelled and the first error is returned.
+func (s *ProjectsService) GetWithAssignments(ctx context.Context, projectID ProjectID) (*ProjectDetails, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var d ProjectDetails
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		var err error
+		if d.Project, err = s.Get(ctx, projectID); err != nil {
+			fail(err)
+		}
+	})
+	wg.Go(func() {
+		var err error
+		if d.UserAssignments, err = s.ListUserAssignments(ctx, projectID, nil); err != nil {
+			fail(err)
+		}
+	})
+	wg.Go(func() {
+		var err error
+		if d.TaskAssignments, err = s.ListTaskAssignments(ctx, projectID, nil); err != nil {
+			fail(err)
+		}
+	})
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return &d, nil
+}
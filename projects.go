@@ -2,8 +2,11 @@ package harvest
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/url"
+	"iter"
+
+	"github.com/shopspring/decimal"
 )
 
 // ProjectsService handles communication with the project related
@@ -15,9 +18,8 @@ type ProjectsService struct {
 // ProjectListOptions specifies optional parameters to the List method.
 type ProjectListOptions struct {
 	ListOptions
-	IsActive     *bool  `url:"is_active,omitempty"`
-	ClientID     int64  `url:"client_id,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	IsActive *bool    `url:"is_active,omitempty"`
+	ClientID ClientID `url:"client_id,omitempty"`
 }
 
 // ProjectList represents a list of projects.
@@ -26,32 +28,40 @@ type ProjectList struct {
 	Paginated[Project]
 }
 
+func (l *ProjectList) page() *Paginated[Project] {
+	l.Projects = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Projects and Items.
+func (l *ProjectList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "projects", &l.Paginated, &l.Projects)
+}
+
 // ListPage returns a single page of projects.
 func (s *ProjectsService) ListPage(ctx context.Context, opts *ProjectListOptions) (*ProjectList, error) {
-	u, err := addOptions("projects", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Project, ProjectList](ctx, s.client, "projects", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all projects across all pages.
+func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) ([]Project, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var projects ProjectList
-	_, err = s.client.Do(ctx, req, &projects)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *ProjectsService) ListWithTotals(ctx context.Context, opts *ProjectListOptions) (*ListResult[Project], error) {
+	if opts == nil {
+		opts = &ProjectListOptions{}
 	}
-
-	// Copy projects to Items for pagination
-	projects.Items = projects.Projects
-
-	return &projects, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Project, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all projects across all pages.
-func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) ([]Project, error) {
+// All returns an iterator over all projects, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *ProjectsService) All(ctx context.Context, opts *ProjectListOptions) iter.Seq2[Project, error] {
 	if opts == nil {
 		opts = &ProjectListOptions{}
 	}
@@ -62,100 +72,247 @@ func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) ([
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allProjects []Project
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allProjects = append(allProjects, result.Projects...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Project](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*ProjectList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all projects on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ProjectsService) Stream(ctx context.Context, opts *ProjectListOptions) (<-chan Project, <-chan error) {
+	if opts == nil {
+		opts = &ProjectListOptions{}
 	}
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
 
-	return allProjects, nil
+// ListActive returns all active projects.
+func (s *ProjectsService) ListActive(ctx context.Context) ([]Project, error) {
+	return s.List(ctx, &ProjectListOptions{IsActive: Bool(true)})
 }
 
 // Get retrieves a specific project.
-func (s *ProjectsService) Get(ctx context.Context, projectID int64) (*Project, error) {
+func (s *ProjectsService) Get(ctx context.Context, projectID ProjectID) (*Project, error) {
 	return Get[Project](ctx, s.client, fmt.Sprintf("projects/%d", projectID))
 }
 
 // ProjectCreateRequest represents a request to create a project.
 type ProjectCreateRequest struct {
-	ClientID                         int64   `json:"client_id"`
-	Name                             string  `json:"name"`
-	Code                             string  `json:"code,omitempty"`
-	IsActive                         *bool   `json:"is_active,omitempty"`
-	IsBillable                       *bool   `json:"is_billable,omitempty"`
-	IsFixedFee                       *bool   `json:"is_fixed_fee,omitempty"`
-	BillBy                           string  `json:"bill_by,omitempty"`
-	Budget                           float64 `json:"budget,omitempty"`
-	BudgetBy                         string  `json:"budget_by,omitempty"`
-	BudgetIsMonthly                  *bool   `json:"budget_is_monthly,omitempty"`
-	NotifyWhenOverBudget             *bool   `json:"notify_when_over_budget,omitempty"`
-	OverBudgetNotificationPercentage float64 `json:"over_budget_notification_percentage,omitempty"`
-	ShowBudgetToAll                  *bool   `json:"show_budget_to_all,omitempty"`
-	CostBudget                       float64 `json:"cost_budget,omitempty"`
-	CostBudgetIncludeExpenses        *bool   `json:"cost_budget_include_expenses,omitempty"`
-	HourlyRate                       float64 `json:"hourly_rate,omitempty"`
-	Fee                              float64 `json:"fee,omitempty"`
-	Notes                            string  `json:"notes,omitempty"`
-	StartsOn                         string  `json:"starts_on,omitempty"`
-	EndsOn                           string  `json:"ends_on,omitempty"`
+	ClientID                         ClientID         `json:"client_id"`
+	Name                             string           `json:"name"`
+	Code                             string           `json:"code,omitempty"`
+	IsActive                         *bool            `json:"is_active,omitempty"`
+	IsBillable                       *bool            `json:"is_billable,omitempty"`
+	IsFixedFee                       *bool            `json:"is_fixed_fee,omitempty"`
+	BillBy                           BillBy           `json:"bill_by,omitempty"`
+	Budget                           *decimal.Decimal `json:"budget,omitempty"`
+	BudgetBy                         BudgetBy         `json:"budget_by,omitempty"`
+	BudgetIsMonthly                  *bool            `json:"budget_is_monthly,omitempty"`
+	NotifyWhenOverBudget             *bool            `json:"notify_when_over_budget,omitempty"`
+	OverBudgetNotificationPercentage *decimal.Decimal `json:"over_budget_notification_percentage,omitempty"`
+	ShowBudgetToAll                  *bool            `json:"show_budget_to_all,omitempty"`
+	CostBudget                       *decimal.Decimal `json:"cost_budget,omitempty"`
+	CostBudgetIncludeExpenses        *bool            `json:"cost_budget_include_expenses,omitempty"`
+	HourlyRate                       *decimal.Decimal `json:"hourly_rate,omitempty"`
+	Fee                              *decimal.Decimal `json:"fee,omitempty"`
+	Notes                            string           `json:"notes,omitempty"`
+	StartsOn                         string           `json:"starts_on,omitempty"`
+	EndsOn                           string           `json:"ends_on,omitempty"`
+}
+
+// SetDates sets the start and end dates from Dates. A zero Date leaves
+// that date unset.
+func (r *ProjectCreateRequest) SetDates(startsOn, endsOn Date) *ProjectCreateRequest {
+	r.StartsOn = dateString(startsOn)
+	r.EndsOn = dateString(endsOn)
+	return r
+}
+
+// Validate checks that the required fields are set.
+func (r *ProjectCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ClientID != 0, "client_id", "is required")
+	errs.require(r.Name != "", "name", "is required")
+	errs.require(r.IsBillable != nil, "is_billable", "is required")
+	errs.require(r.BillBy != "", "bill_by", "is required")
+	errs.require(r.BillBy == "" || r.BillBy.Valid(), "bill_by", "is not a known bill-by method")
+	errs.require(r.BudgetBy != "", "budget_by", "is required")
+	errs.require(r.BudgetBy == "" || r.BudgetBy.Valid(), "budget_by", "is not a known budget-by method")
+	errs.date(r.StartsOn, "starts_on")
+	errs.date(r.EndsOn, "ends_on")
+	return errs.err()
+}
+
+// DuplicateCodePolicy controls what ProjectsService.Create does when a
+// project with the same Code already exists. Harvest itself allows
+// duplicate codes.
+type DuplicateCodePolicy int
+
+const (
+	// DuplicateCodeAllow creates the project without checking. This is the default.
+	DuplicateCodeAllow DuplicateCodePolicy = iota
+	// DuplicateCodeFail returns a *DuplicateCodeError instead of creating the project.
+	DuplicateCodeFail
+	// DuplicateCodeReuse returns the existing project instead of creating a new one.
+	DuplicateCodeReuse
+)
+
+// ErrDuplicateCode is wrapped by the error returned when a project code is
+// already in use.
+var ErrDuplicateCode = errors.New("harvest: duplicate project code")
+
+// DuplicateCodeError is returned by ProjectsService.Create under
+// DuplicateCodeFail when a project with the same code exists.
+type DuplicateCodeError struct {
+	Code     string
+	Existing *Project
+}
+
+func (e *DuplicateCodeError) Error() string {
+	return fmt.Sprintf("harvest: project code %q is already used by project %d (%s)", e.Code, e.Existing.ID, e.Existing.Name)
+}
+
+// Unwrap returns ErrDuplicateCode.
+func (e *DuplicateCodeError) Unwrap() error {
+	return ErrDuplicateCode
+}
+
+// ProjectCreateOption configures a call to ProjectsService.Create.
+type ProjectCreateOption func(*projectCreateOptions)
+
+type projectCreateOptions struct {
+	duplicateCode DuplicateCodePolicy
+}
+
+// WithDuplicateCode sets how Create handles a Code that is already used by
+// another project. Requests without a Code are never checked.
+func WithDuplicateCode(policy DuplicateCodePolicy) ProjectCreateOption {
+	return func(o *projectCreateOptions) {
+		o.duplicateCode = policy
+	}
 }
 
 // Create creates a new project.
-func (s *ProjectsService) Create(ctx context.Context, project *ProjectCreateRequest) (*Project, error) {
+func (s *ProjectsService) Create(ctx context.Context, project *ProjectCreateRequest, opts ...ProjectCreateOption) (*Project, error) {
+	var o projectCreateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.duplicateCode != DuplicateCodeAllow && project != nil && project.Code != "" {
+		existing, err := s.findByCode(ctx, project.Code)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			if o.duplicateCode == DuplicateCodeReuse {
+				return existing, nil
+			}
+			return nil, &DuplicateCodeError{Code: project.Code, Existing: existing}
+		}
+	}
+
 	return Create[Project](ctx, s.client, "projects", project)
 }
 
+// findByCode returns the first project whose Code matches code, or nil if
+// there is none.
+func (s *ProjectsService) findByCode(ctx context.Context, code string) (*Project, error) {
+	for project, err := range s.All(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		if project.Code == code {
+			return &project, nil
+		}
+	}
+	return nil, nil
+}
+
 // ProjectUpdateRequest represents a request to update a project.
 type ProjectUpdateRequest struct {
-	ClientID                         int64   `json:"client_id,omitempty"`
-	Name                             string  `json:"name,omitempty"`
-	Code                             string  `json:"code,omitempty"`
-	IsActive                         *bool   `json:"is_active,omitempty"`
-	IsBillable                       *bool   `json:"is_billable,omitempty"`
-	IsFixedFee                       *bool   `json:"is_fixed_fee,omitempty"`
-	BillBy                           string  `json:"bill_by,omitempty"`
-	Budget                           float64 `json:"budget,omitempty"`
-	BudgetBy                         string  `json:"budget_by,omitempty"`
-	BudgetIsMonthly                  *bool   `json:"budget_is_monthly,omitempty"`
-	NotifyWhenOverBudget             *bool   `json:"notify_when_over_budget,omitempty"`
-	OverBudgetNotificationPercentage float64 `json:"over_budget_notification_percentage,omitempty"`
-	ShowBudgetToAll                  *bool   `json:"show_budget_to_all,omitempty"`
-	CostBudget                       float64 `json:"cost_budget,omitempty"`
-	CostBudgetIncludeExpenses        *bool   `json:"cost_budget_include_expenses,omitempty"`
-	HourlyRate                       float64 `json:"hourly_rate,omitempty"`
-	Fee                              float64 `json:"fee,omitempty"`
-	Notes                            string  `json:"notes,omitempty"`
-	StartsOn                         string  `json:"starts_on,omitempty"`
-	EndsOn                           string  `json:"ends_on,omitempty"`
+	ClientID                         ClientID                  `json:"client_id,omitempty"`
+	Name                             string                    `json:"name,omitempty"`
+	Code                             Nullable[string]          `json:"code,omitzero"`
+	IsActive                         *bool                     `json:"is_active,omitempty"`
+	IsBillable                       *bool                     `json:"is_billable,omitempty"`
+	IsFixedFee                       *bool                     `json:"is_fixed_fee,omitempty"`
+	BillBy                           BillBy                    `json:"bill_by,omitempty"`
+	Budget                           Nullable[decimal.Decimal] `json:"budget,omitzero"`
+	BudgetBy                         BudgetBy                  `json:"budget_by,omitempty"`
+	BudgetIsMonthly                  *bool                     `json:"budget_is_monthly,omitempty"`
+	NotifyWhenOverBudget             *bool                     `json:"notify_when_over_budget,omitempty"`
+	OverBudgetNotificationPercentage Nullable[decimal.Decimal] `json:"over_budget_notification_percentage,omitzero"`
+	ShowBudgetToAll                  *bool                     `json:"show_budget_to_all,omitempty"`
+	CostBudget                       Nullable[decimal.Decimal] `json:"cost_budget,omitzero"`
+	CostBudgetIncludeExpenses        *bool                     `json:"cost_budget_include_expenses,omitempty"`
+	HourlyRate                       Nullable[decimal.Decimal] `json:"hourly_rate,omitzero"`
+	Fee                              Nullable[decimal.Decimal] `json:"fee,omitzero"`
+	Notes                            Nullable[string]          `json:"notes,omitzero"`
+	StartsOn                         Nullable[string]          `json:"starts_on,omitzero"`
+	EndsOn                           Nullable[string]          `json:"ends_on,omitzero"`
+}
+
+// SetDates sets the start and end dates from Dates. An unset Nullable
+// leaves that date unchanged and Null clears it.
+func (r *ProjectUpdateRequest) SetDates(startsOn, endsOn Nullable[Date]) *ProjectUpdateRequest {
+	r.StartsOn = nullableDate(startsOn)
+	r.EndsOn = nullableDate(endsOn)
+	return r
+}
+
+// nullableDate converts a Nullable Date to the string form requests use.
+func nullableDate(d Nullable[Date]) Nullable[string] {
+	switch {
+	case d.IsZero():
+		return Nullable[string]{}
+	case d.IsNull():
+		return Null[string]()
+	default:
+		return Set(dateString(d.Value()))
+	}
+}
+
+// Validate checks that any dates are well formed.
+func (r *ProjectUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.BillBy == "" || r.BillBy.Valid(), "bill_by", "is not a known bill-by method")
+	errs.require(r.BudgetBy == "" || r.BudgetBy.Valid(), "budget_by", "is not a known budget-by method")
+	errs.date(r.StartsOn.Value(), "starts_on")
+	errs.date(r.EndsOn.Value(), "ends_on")
+	return errs.err()
 }
 
 // Update updates a project.
-func (s *ProjectsService) Update(ctx context.Context, projectID int64, project *ProjectUpdateRequest) (*Project, error) {
+func (s *ProjectsService) Update(ctx context.Context, projectID ProjectID, project *ProjectUpdateRequest) (*Project, error) {
 	return Update[Project](ctx, s.client, fmt.Sprintf("projects/%d", projectID), project)
 }
 
+// Archive deactivates a project.
+func (s *ProjectsService) Archive(ctx context.Context, projectID ProjectID) (*Project, error) {
+	return s.Update(ctx, projectID, &ProjectUpdateRequest{IsActive: Bool(false)})
+}
+
+// Restore reactivates an archived project.
+func (s *ProjectsService) Restore(ctx context.Context, projectID ProjectID) (*Project, error) {
+	return s.Update(ctx, projectID, &ProjectUpdateRequest{IsActive: Bool(true)})
+}
+
 // Delete deletes a project.
-func (s *ProjectsService) Delete(ctx context.Context, projectID int64) error {
+func (s *ProjectsService) Delete(ctx context.Context, projectID ProjectID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("projects/%d", projectID))
 }
 
 // UserAssignmentListOptions specifies optional parameters for listing user assignments.
 type UserAssignmentListOptions struct {
 	ListOptions
-	UserID       int64  `url:"user_id,omitempty"`
-	IsActive     *bool  `url:"is_active,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	UserID   UserID `url:"user_id,omitempty"`
+	IsActive *bool  `url:"is_active,omitempty"`
 }
 
 // UserAssignmentList represents a list of user assignments.
@@ -164,33 +321,42 @@ type UserAssignmentList struct {
 	Paginated[ProjectUserAssignment]
 }
 
-// ListUserAssignmentsPage returns a single page of user assignments for a project.
-func (s *ProjectsService) ListUserAssignmentsPage(ctx context.Context, projectID int64, opts *UserAssignmentListOptions) (*UserAssignmentList, error) {
-	u, err := addOptions(fmt.Sprintf("projects/%d/user_assignments", projectID), opts)
-	if err != nil {
-		return nil, err
-	}
+func (l *UserAssignmentList) page() *Paginated[ProjectUserAssignment] {
+	l.UserAssignments = l.Items
+	return &l.Paginated
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// UnmarshalJSON implements json.Unmarshaler, filling both UserAssignments and Items.
+func (l *UserAssignmentList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "user_assignments", &l.Paginated, &l.UserAssignments)
+}
 
-	var assignments UserAssignmentList
-	_, err = s.client.Do(ctx, req, &assignments)
-	if err != nil {
-		return nil, err
-	}
+// ListUserAssignmentsPage returns a single page of user assignments for a project.
+func (s *ProjectsService) ListUserAssignmentsPage(ctx context.Context, projectID ProjectID, opts *UserAssignmentListOptions) (*UserAssignmentList, error) {
+	return listPage[ProjectUserAssignment, UserAssignmentList](ctx, s.client, fmt.Sprintf("projects/%d/user_assignments", projectID), opts)
+}
 
-	// Copy assignments to Items for pagination
-	assignments.Items = assignments.UserAssignments
+// ListUserAssignments returns all user assignments for a project across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *ProjectsService) ListUserAssignments(ctx context.Context, projectID ProjectID, opts *UserAssignmentListOptions) ([]ProjectUserAssignment, error) {
+	return collect(s.AllUserAssignments(ctx, projectID, opts))
+}
 
-	return &assignments, nil
+// ListUserAssignmentsWithTotals is like ListUserAssignments but also returns the totals and rate
+// limit reported by the API.
+func (s *ProjectsService) ListUserAssignmentsWithTotals(ctx context.Context, projectID ProjectID, opts *UserAssignmentListOptions) (*ListResult[ProjectUserAssignment], error) {
+	if opts == nil {
+		opts = &UserAssignmentListOptions{}
+	}
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[ProjectUserAssignment, error] {
+		return s.AllUserAssignments(ctx, projectID, opts)
+	})
 }
 
-// ListUserAssignments returns all user assignments for a project across all pages.
+// AllUserAssignments returns an iterator over all user assignments for a project, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
 // This endpoint uses cursor-based pagination.
-func (s *ProjectsService) ListUserAssignments(ctx context.Context, projectID int64, opts *UserAssignmentListOptions) ([]ProjectUserAssignment, error) {
+func (s *ProjectsService) AllUserAssignments(ctx context.Context, projectID ProjectID, opts *UserAssignmentListOptions) iter.Seq2[ProjectUserAssignment, error] {
 	if opts == nil {
 		opts = &UserAssignmentListOptions{}
 	}
@@ -199,102 +365,75 @@ func (s *ProjectsService) ListUserAssignments(ctx context.Context, projectID int
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allAssignments []ProjectUserAssignment
-
-	// Fetch first page
-	result, err := s.ListUserAssignmentsPage(ctx, projectID, opts)
-	if err != nil {
-		return nil, err
-	}
-	allAssignments = append(allAssignments, result.UserAssignments...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Parse the URL to get path and query
-			u, err := url.Parse(nextURL)
-			if err != nil {
-				return nil, err
-			}
-			pathAndQuery := u.Path
-			if u.RawQuery != "" {
-				pathAndQuery += "?" + u.RawQuery
-			}
-
-			req, err := s.client.NewRequest(ctx, "GET", pathAndQuery, nil)
-			if err != nil {
-				return nil, err
-			}
+	return all[ProjectUserAssignment](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*UserAssignmentList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListUserAssignmentsPage(ctx, projectID, &o)
+	})
+}
 
-			var assignments UserAssignmentList
-			_, err = s.client.Do(ctx, req, &assignments)
-			if err != nil {
-				return nil, err
-			}
-			assignments.Items = assignments.UserAssignments
-			result = &assignments
-			allAssignments = append(allAssignments, assignments.UserAssignments...)
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = s.ListUserAssignmentsPage(ctx, projectID, opts)
-			if err != nil {
-				return nil, err
-			}
-			allAssignments = append(allAssignments, result.UserAssignments...)
-		} else {
-			break
-		}
+// StreamUserAssignments sends all user assignments for a project on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ProjectsService) StreamUserAssignments(ctx context.Context, projectID ProjectID, opts *UserAssignmentListOptions) (<-chan ProjectUserAssignment, <-chan error) {
+	if opts == nil {
+		opts = &UserAssignmentListOptions{}
 	}
-
-	return allAssignments, nil
+	seq := s.AllUserAssignments(ctx, projectID, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // GetUserAssignment retrieves a specific user assignment.
-func (s *ProjectsService) GetUserAssignment(ctx context.Context, projectID, userAssignmentID int64) (*ProjectUserAssignment, error) {
+func (s *ProjectsService) GetUserAssignment(ctx context.Context, projectID ProjectID, userAssignmentID UserAssignmentID) (*ProjectUserAssignment, error) {
 	return Get[ProjectUserAssignment](ctx, s.client, fmt.Sprintf("projects/%d/user_assignments/%d", projectID, userAssignmentID))
 }
 
 // UserAssignmentCreateRequest represents a request to create a user assignment.
 type UserAssignmentCreateRequest struct {
-	UserID           int64   `json:"user_id"`
-	IsActive         *bool   `json:"is_active,omitempty"`
-	IsProjectManager *bool   `json:"is_project_manager,omitempty"`
-	UseDefaultRates  *bool   `json:"use_default_rates,omitempty"`
-	HourlyRate       float64 `json:"hourly_rate,omitempty"`
-	Budget           float64 `json:"budget,omitempty"`
+	UserID           UserID           `json:"user_id"`
+	IsActive         *bool            `json:"is_active,omitempty"`
+	IsProjectManager *bool            `json:"is_project_manager,omitempty"`
+	UseDefaultRates  *bool            `json:"use_default_rates,omitempty"`
+	HourlyRate       *decimal.Decimal `json:"hourly_rate,omitempty"`
+	Budget           *decimal.Decimal `json:"budget,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *UserAssignmentCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.UserID != 0, "user_id", "is required")
+	return errs.err()
 }
 
 // CreateUserAssignment creates a new user assignment for a project.
-func (s *ProjectsService) CreateUserAssignment(ctx context.Context, projectID int64, assignment *UserAssignmentCreateRequest) (*ProjectUserAssignment, error) {
+func (s *ProjectsService) CreateUserAssignment(ctx context.Context, projectID ProjectID, assignment *UserAssignmentCreateRequest) (*ProjectUserAssignment, error) {
 	return Create[ProjectUserAssignment](ctx, s.client, fmt.Sprintf("projects/%d/user_assignments", projectID), assignment)
 }
 
 // UserAssignmentUpdateRequest represents a request to update a user assignment.
 type UserAssignmentUpdateRequest struct {
-	IsActive         *bool   `json:"is_active,omitempty"`
-	IsProjectManager *bool   `json:"is_project_manager,omitempty"`
-	UseDefaultRates  *bool   `json:"use_default_rates,omitempty"`
-	HourlyRate       float64 `json:"hourly_rate,omitempty"`
-	Budget           float64 `json:"budget,omitempty"`
+	IsActive         *bool                     `json:"is_active,omitempty"`
+	IsProjectManager *bool                     `json:"is_project_manager,omitempty"`
+	UseDefaultRates  *bool                     `json:"use_default_rates,omitempty"`
+	HourlyRate       Nullable[decimal.Decimal] `json:"hourly_rate,omitzero"`
+	Budget           Nullable[decimal.Decimal] `json:"budget,omitzero"`
 }
 
 // UpdateUserAssignment updates a user assignment.
-func (s *ProjectsService) UpdateUserAssignment(ctx context.Context, projectID, userAssignmentID int64, assignment *UserAssignmentUpdateRequest) (*ProjectUserAssignment, error) {
+func (s *ProjectsService) UpdateUserAssignment(ctx context.Context, projectID ProjectID, userAssignmentID UserAssignmentID, assignment *UserAssignmentUpdateRequest) (*ProjectUserAssignment, error) {
 	return Update[ProjectUserAssignment](ctx, s.client, fmt.Sprintf("projects/%d/user_assignments/%d", projectID, userAssignmentID), assignment)
 }
 
 // DeleteUserAssignment deletes a user assignment.
-func (s *ProjectsService) DeleteUserAssignment(ctx context.Context, projectID, userAssignmentID int64) error {
+func (s *ProjectsService) DeleteUserAssignment(ctx context.Context, projectID ProjectID, userAssignmentID UserAssignmentID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("projects/%d/user_assignments/%d", projectID, userAssignmentID))
 }
 
 // TaskAssignmentListOptions specifies optional parameters for listing task assignments.
 type TaskAssignmentListOptions struct {
 	ListOptions
-	IsActive     *bool  `url:"is_active,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	IsActive *bool `url:"is_active,omitempty"`
 }
 
 // TaskAssignmentList represents a list of task assignments.
@@ -303,33 +442,42 @@ type TaskAssignmentList struct {
 	Paginated[ProjectTaskAssignment]
 }
 
-// ListTaskAssignmentsPage returns a single page of task assignments for a project.
-func (s *ProjectsService) ListTaskAssignmentsPage(ctx context.Context, projectID int64, opts *TaskAssignmentListOptions) (*TaskAssignmentList, error) {
-	u, err := addOptions(fmt.Sprintf("projects/%d/task_assignments", projectID), opts)
-	if err != nil {
-		return nil, err
-	}
+func (l *TaskAssignmentList) page() *Paginated[ProjectTaskAssignment] {
+	l.TaskAssignments = l.Items
+	return &l.Paginated
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// UnmarshalJSON implements json.Unmarshaler, filling both TaskAssignments and Items.
+func (l *TaskAssignmentList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "task_assignments", &l.Paginated, &l.TaskAssignments)
+}
 
-	var assignments TaskAssignmentList
-	_, err = s.client.Do(ctx, req, &assignments)
-	if err != nil {
-		return nil, err
-	}
+// ListTaskAssignmentsPage returns a single page of task assignments for a project.
+func (s *ProjectsService) ListTaskAssignmentsPage(ctx context.Context, projectID ProjectID, opts *TaskAssignmentListOptions) (*TaskAssignmentList, error) {
+	return listPage[ProjectTaskAssignment, TaskAssignmentList](ctx, s.client, fmt.Sprintf("projects/%d/task_assignments", projectID), opts)
+}
 
-	// Copy assignments to Items for pagination
-	assignments.Items = assignments.TaskAssignments
+// ListTaskAssignments returns all task assignments for a project across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *ProjectsService) ListTaskAssignments(ctx context.Context, projectID ProjectID, opts *TaskAssignmentListOptions) ([]ProjectTaskAssignment, error) {
+	return collect(s.AllTaskAssignments(ctx, projectID, opts))
+}
 
-	return &assignments, nil
+// ListTaskAssignmentsWithTotals is like ListTaskAssignments but also returns the totals and rate
+// limit reported by the API.
+func (s *ProjectsService) ListTaskAssignmentsWithTotals(ctx context.Context, projectID ProjectID, opts *TaskAssignmentListOptions) (*ListResult[ProjectTaskAssignment], error) {
+	if opts == nil {
+		opts = &TaskAssignmentListOptions{}
+	}
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[ProjectTaskAssignment, error] {
+		return s.AllTaskAssignments(ctx, projectID, opts)
+	})
 }
 
-// ListTaskAssignments returns all task assignments for a project across all pages.
+// AllTaskAssignments returns an iterator over all task assignments for a project, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
 // This endpoint uses cursor-based pagination.
-func (s *ProjectsService) ListTaskAssignments(ctx context.Context, projectID int64, opts *TaskAssignmentListOptions) ([]ProjectTaskAssignment, error) {
+func (s *ProjectsService) AllTaskAssignments(ctx context.Context, projectID ProjectID, opts *TaskAssignmentListOptions) iter.Seq2[ProjectTaskAssignment, error] {
 	if opts == nil {
 		opts = &TaskAssignmentListOptions{}
 	}
@@ -338,91 +486,65 @@ func (s *ProjectsService) ListTaskAssignments(ctx context.Context, projectID int
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allAssignments []ProjectTaskAssignment
-
-	// Fetch first page
-	result, err := s.ListTaskAssignmentsPage(ctx, projectID, opts)
-	if err != nil {
-		return nil, err
-	}
-	allAssignments = append(allAssignments, result.TaskAssignments...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Parse the URL to get path and query
-			u, err := url.Parse(nextURL)
-			if err != nil {
-				return nil, err
-			}
-			pathAndQuery := u.Path
-			if u.RawQuery != "" {
-				pathAndQuery += "?" + u.RawQuery
-			}
-
-			req, err := s.client.NewRequest(ctx, "GET", pathAndQuery, nil)
-			if err != nil {
-				return nil, err
-			}
+	return all[ProjectTaskAssignment](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*TaskAssignmentList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListTaskAssignmentsPage(ctx, projectID, &o)
+	})
+}
 
-			var assignments TaskAssignmentList
-			_, err = s.client.Do(ctx, req, &assignments)
-			if err != nil {
-				return nil, err
-			}
-			assignments.Items = assignments.TaskAssignments
-			result = &assignments
-			allAssignments = append(allAssignments, assignments.TaskAssignments...)
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = s.ListTaskAssignmentsPage(ctx, projectID, opts)
-			if err != nil {
-				return nil, err
-			}
-			allAssignments = append(allAssignments, result.TaskAssignments...)
-		} else {
-			break
-		}
+// StreamTaskAssignments sends all task assignments for a project on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *ProjectsService) StreamTaskAssignments(ctx context.Context, projectID ProjectID, opts *TaskAssignmentListOptions) (<-chan ProjectTaskAssignment, <-chan error) {
+	if opts == nil {
+		opts = &TaskAssignmentListOptions{}
 	}
-
-	return allAssignments, nil
+	seq := s.AllTaskAssignments(ctx, projectID, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // GetTaskAssignment retrieves a specific task assignment.
-func (s *ProjectsService) GetTaskAssignment(ctx context.Context, projectID, taskAssignmentID int64) (*ProjectTaskAssignment, error) {
+func (s *ProjectsService) GetTaskAssignment(ctx context.Context, projectID ProjectID, taskAssignmentID TaskAssignmentID) (*ProjectTaskAssignment, error) {
 	return Get[ProjectTaskAssignment](ctx, s.client, fmt.Sprintf("projects/%d/task_assignments/%d", projectID, taskAssignmentID))
 }
 
 // TaskAssignmentCreateRequest represents a request to create a task assignment.
 type TaskAssignmentCreateRequest struct {
-	TaskID     int64   `json:"task_id"`
-	IsActive   *bool   `json:"is_active,omitempty"`
-	Billable   *bool   `json:"billable,omitempty"`
-	HourlyRate float64 `json:"hourly_rate,omitempty"`
-	Budget     float64 `json:"budget,omitempty"`
+	TaskID     TaskID           `json:"task_id"`
+	IsActive   *bool            `json:"is_active,omitempty"`
+	Billable   *bool            `json:"billable,omitempty"`
+	HourlyRate *decimal.Decimal `json:"hourly_rate,omitempty"`
+	Budget     *decimal.Decimal `json:"budget,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *TaskAssignmentCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.TaskID != 0, "task_id", "is required")
+	return errs.err()
 }
 
 // CreateTaskAssignment creates a new task assignment for a project.
-func (s *ProjectsService) CreateTaskAssignment(ctx context.Context, projectID int64, assignment *TaskAssignmentCreateRequest) (*ProjectTaskAssignment, error) {
+func (s *ProjectsService) CreateTaskAssignment(ctx context.Context, projectID ProjectID, assignment *TaskAssignmentCreateRequest) (*ProjectTaskAssignment, error) {
 	return Create[ProjectTaskAssignment](ctx, s.client, fmt.Sprintf("projects/%d/task_assignments", projectID), assignment)
 }
 
 // TaskAssignmentUpdateRequest represents a request to update a task assignment.
 type TaskAssignmentUpdateRequest struct {
-	IsActive   *bool   `json:"is_active,omitempty"`
-	Billable   *bool   `json:"billable,omitempty"`
-	HourlyRate float64 `json:"hourly_rate,omitempty"`
-	Budget     float64 `json:"budget,omitempty"`
+	IsActive   *bool                     `json:"is_active,omitempty"`
+	Billable   *bool                     `json:"billable,omitempty"`
+	HourlyRate Nullable[decimal.Decimal] `json:"hourly_rate,omitzero"`
+	Budget     Nullable[decimal.Decimal] `json:"budget,omitzero"`
 }
 
 // UpdateTaskAssignment updates a task assignment.
-func (s *ProjectsService) UpdateTaskAssignment(ctx context.Context, projectID, taskAssignmentID int64, assignment *TaskAssignmentUpdateRequest) (*ProjectTaskAssignment, error) {
+func (s *ProjectsService) UpdateTaskAssignment(ctx context.Context, projectID ProjectID, taskAssignmentID TaskAssignmentID, assignment *TaskAssignmentUpdateRequest) (*ProjectTaskAssignment, error) {
 	return Update[ProjectTaskAssignment](ctx, s.client, fmt.Sprintf("projects/%d/task_assignments/%d", projectID, taskAssignmentID), assignment)
 }
 
 // DeleteTaskAssignment deletes a task assignment.
-func (s *ProjectsService) DeleteTaskAssignment(ctx context.Context, projectID, taskAssignmentID int64) error {
+func (s *ProjectsService) DeleteTaskAssignment(ctx context.Context, projectID ProjectID, taskAssignmentID TaskAssignmentID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("projects/%d/task_assignments/%d", projectID, taskAssignmentID))
 }
@@ -0,0 +1,146 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateCard is a named set of hourly rates applied to a project's
+// assignments. Task rates apply to task assignments by task name and are
+// used when the project bills by task; role rates apply to user
+// assignments by the user's roles and are used when it bills by person.
+// Names are matched case-insensitively, so no two task or role names may
+// differ only in case.
+type RateCard struct {
+	Name  string
+	Tasks map[string]decimal.Decimal
+	Roles map[string]decimal.Decimal
+}
+
+// Validate checks that no two task or role names differ only in case.
+func (c RateCard) Validate() error {
+	var errs fieldErrors
+	errs.uniqueFold(c.Tasks, "tasks")
+	errs.uniqueFold(c.Roles, "roles")
+	return errs.err()
+}
+
+// RateChange is one assignment whose hourly rate ApplyRateCard changed.
+type RateChange struct {
+	// Kind is "task" or "user".
+	Kind string
+	// AssignmentID is the ID of the task or user assignment.
+	AssignmentID int64
+	// Name is the task name or the user's name.
+	Name string
+	// Match is the task or role name the rate was chosen by.
+	Match string
+	Old   *decimal.Decimal
+	New   decimal.Decimal
+}
+
+func (c RateChange) String() string {
+	old := "default"
+	if c.Old != nil {
+		old = c.Old.String()
+	}
+	return fmt.Sprintf("%s %s (%s): %s -> %s", c.Kind, c.Name, c.Match, old, c.New)
+}
+
+// ApplyRateCard sets the hourly rates of a project's task and user
+// assignments from card, updating only assignments whose rate differs, and
+// returns the changes made. A user with several roles in the card gets the
+// rate of the first of those roles in the order Harvest lists the user's
+// roles. Assignments the card doesn't cover are left alone. It returns a
+// *ValidationError before making any request if card fails Validate. If an
+// update fails, the changes made so far are returned with the error.
+//
+// Experimental: this API may change in minor releases.
+func (s *ProjectsService) ApplyRateCard(ctx context.Context, projectID ProjectID, card RateCard) ([]RateChange, error) {
+	if err := card.Validate(); err != nil {
+		return nil, err
+	}
+	var changes []RateChange
+
+	if len(card.Tasks) > 0 {
+		assignments, err := s.ListTaskAssignments(ctx, projectID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range assignments {
+			if a.Task == nil {
+				continue
+			}
+			match, rate, ok := lookupRate(card.Tasks, a.Task.Name)
+			if !ok || rateEqual(a.HourlyRate, rate) {
+				continue
+			}
+			if _, err := s.UpdateTaskAssignment(ctx, projectID, a.ID, &TaskAssignmentUpdateRequest{HourlyRate: Set(rate)}); err != nil {
+				return changes, err
+			}
+			changes = append(changes, RateChange{Kind: "task", AssignmentID: int64(a.ID), Name: a.Task.Name, Match: match, Old: a.HourlyRate, New: rate})
+		}
+	}
+
+	if len(card.Roles) > 0 {
+		users, err := s.client.Users.List(ctx, nil)
+		if err != nil {
+			return changes, err
+		}
+		roles := make(map[UserID][]string, len(users))
+		for _, u := range users {
+			roles[u.ID] = u.Roles
+		}
+
+		assignments, err := s.ListUserAssignments(ctx, projectID, nil)
+		if err != nil {
+			return changes, err
+		}
+		for _, a := range assignments {
+			if a.User == nil {
+				continue
+			}
+			var match string
+			var rate decimal.Decimal
+			var ok bool
+			for _, role := range roles[a.User.ID] {
+				if match, rate, ok = lookupRate(card.Roles, role); ok {
+					break
+				}
+			}
+			if !ok || (!a.UseDefaultRates && rateEqual(a.HourlyRate, rate)) {
+				continue
+			}
+			update := &UserAssignmentUpdateRequest{UseDefaultRates: Bool(false), HourlyRate: Set(rate)}
+			if _, err := s.UpdateUserAssignment(ctx, projectID, a.ID, update); err != nil {
+				return changes, err
+			}
+			old := a.HourlyRate
+			if a.UseDefaultRates {
+				old = nil
+			}
+			changes = append(changes, RateChange{Kind: "user", AssignmentID: int64(a.ID), Name: userFullName(a.User), Match: match, Old: old, New: rate})
+		}
+	}
+
+	return changes, nil
+}
+
+// lookupRate finds name in rates case-insensitively, returning the matching
+// key and its rate.
+func lookupRate(rates map[string]decimal.Decimal, name string) (string, decimal.Decimal, bool) {
+	for key, rate := range rates {
+		if strings.EqualFold(key, name) {
+			return key, rate, true
+		}
+	}
+	return "", decimal.Decimal{}, false
+}
+
+// rateEqual reports whether current is set and equal to rate.
+func rateEqual(current *decimal.Decimal, rate decimal.Decimal) bool {
+	return current != nil && current.Equal(rate)
+}
@@ -0,0 +1,31 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestApplyRateCardRejectsCaseDuplicates(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	card := RateCard{
+		Name: "2025",
+		Roles: map[string]decimal.Decimal{
+			"Designer": decimal.NewFromInt(150),
+			"designer": decimal.NewFromInt(175),
+		},
+	}
+	_, err := c.Projects.ApplyRateCard(context.Background(), 14307913, card)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("err = %v, want a *ValidationError", err)
+	}
+	if len(verr.Errors) != 1 || verr.Errors[0].Field != "roles" {
+		t.Errorf("errors = %+v, want one for roles", verr.Errors)
+	}
+}
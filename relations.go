@@ -0,0 +1,71 @@
+package harvest
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Relations that Harvest may leave out of a response depending on the
+// permissions of the token. Admin tokens always receive them; tokens for
+// managers and members may get null, an empty value or no key at all.
+const (
+	RelationUserAssignment = "user_assignment"
+	RelationTaskAssignment = "task_assignment"
+)
+
+// UnmarshalJSON implements json.Unmarshaler for TimeEntry. The
+// user_assignment and task_assignment objects are optional: when they are
+// missing or not objects the fields are left nil and the relation is listed
+// in Unavailable instead of failing the whole response.
+func (t *TimeEntry) UnmarshalJSON(data []byte) error {
+	type timeEntry TimeEntry
+	var raw struct {
+		timeEntry
+		UserAssignment json.RawMessage `json:"user_assignment"`
+		TaskAssignment json.RawMessage `json:"task_assignment"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*t = TimeEntry(raw.timeEntry)
+	t.UserAssignment = decodeRelation[ProjectUserAssignment](raw.UserAssignment, RelationUserAssignment, &t.Unavailable)
+	t.TaskAssignment = decodeRelation[ProjectTaskAssignment](raw.TaskAssignment, RelationTaskAssignment, &t.Unavailable)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Expense. A missing or
+// malformed user_assignment is listed in Unavailable rather than treated as
+// an error.
+func (e *Expense) UnmarshalJSON(data []byte) error {
+	type expense Expense
+	var raw struct {
+		expense
+		UserAssignment json.RawMessage `json:"user_assignment"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*e = Expense(raw.expense)
+	e.UserAssignment = decodeRelation[ProjectUserAssignment](raw.UserAssignment, RelationUserAssignment, &e.Unavailable)
+	return nil
+}
+
+// decodeRelation decodes an optional nested object. It returns nil and
+// appends name to unavailable when data is absent, null, not an object, or
+// doesn't decode.
+func decodeRelation[T any](data json.RawMessage, name string, unavailable *[]string) *T {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		*unavailable = append(*unavailable, name)
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		*unavailable = append(*unavailable, name)
+		return nil
+	}
+	return &v
+}
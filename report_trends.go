@@ -0,0 +1,346 @@
+package harvest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Period represents an inclusive date range used to run the same report
+// over different windows.
+type Period struct {
+	From Date `json:"from"`
+	To   Date `json:"to"`
+}
+
+// Days returns the number of days covered by the period, inclusive.
+func (p Period) Days() int {
+	return int(p.To.Sub(p.From.Time)/(24*time.Hour)) + 1
+}
+
+// Previous returns the period of equal length immediately preceding p.
+func (p Period) Previous() Period {
+	to := p.From.AddDate(0, 0, -1)
+	return Period{
+		From: Date{to.AddDate(0, 0, -(p.Days() - 1))},
+		To:   Date{to},
+	}
+}
+
+// YearAgo returns p shifted back by one calendar year.
+func (p Period) YearAgo() Period {
+	return Period{
+		From: Date{p.From.AddDate(-1, 0, 0)},
+		To:   Date{p.To.AddDate(-1, 0, 0)},
+	}
+}
+
+// PercentChange returns the change from previous to current as a percentage.
+// It returns nil when previous is zero and the change is undefined.
+func PercentChange(current, previous decimal.Decimal) *decimal.Decimal {
+	if previous.IsZero() {
+		return nil
+	}
+	change := current.Sub(previous).Div(previous).Mul(decimal.NewFromInt(100))
+	return &change
+}
+
+// TimeReportKey identifies a time report row across periods.
+// Harvest reports one row per currency, so the currency is part of the key.
+type TimeReportKey struct {
+	ClientID  ClientID  `json:"client_id,omitempty"`
+	ProjectID ProjectID `json:"project_id,omitempty"`
+	TaskID    TaskID    `json:"task_id,omitempty"`
+	UserID    UserID    `json:"user_id,omitempty"`
+	Currency  string    `json:"currency"`
+}
+
+// Key returns the key identifying r across periods.
+func (r TimeReport) Key() TimeReportKey {
+	return TimeReportKey{
+		ClientID:  r.ClientID,
+		ProjectID: r.ProjectID,
+		TaskID:    r.TaskID,
+		UserID:    r.UserID,
+		Currency:  r.Currency,
+	}
+}
+
+func compareTimeReportKeys(a, b TimeReportKey) int {
+	return cmp.Or(
+		cmp.Compare(a.ClientID, b.ClientID),
+		cmp.Compare(a.ProjectID, b.ProjectID),
+		cmp.Compare(a.TaskID, b.TaskID),
+		cmp.Compare(a.UserID, b.UserID),
+		cmp.Compare(a.Currency, b.Currency),
+	)
+}
+
+// withoutTotals returns r with its identifying fields intact and all totals zeroed.
+func (r TimeReport) withoutTotals() TimeReport {
+	r.TotalHours = decimal.Zero
+	r.BillableHours = decimal.Zero
+	r.BillableAmount = decimal.Zero
+	return r
+}
+
+// TimeReportSeries is a single time report row aligned across several periods.
+// Values has one entry per requested period; periods in which the row did not
+// appear hold a zero-total entry.
+type TimeReportSeries struct {
+	Key    TimeReportKey `json:"key"`
+	Values []TimeReport  `json:"values"`
+}
+
+// TimeReportTrend runs the time report described by opts once per period and
+// returns the rows aligned across periods, ordered by key.
+// The From and To fields of opts are ignored.
+//
+// Experimental: this API may change in minor releases.
+func (s *ReportsService) TimeReportTrend(ctx context.Context, opts *TimeReportsOptions, periods []Period) ([]TimeReportSeries, error) {
+	results := make([][]TimeReport, len(periods))
+	for i, p := range periods {
+		o := TimeReportsOptions{}
+		if opts != nil {
+			o = *opts
+		}
+		o.From = p.From.String()
+		o.To = p.To.String()
+
+		rows, err := collect(s.AllTimeReports(ctx, &o))
+		if err != nil {
+			return nil, err
+		}
+		results[i] = rows
+	}
+
+	return AlignTimeReports(results), nil
+}
+
+// AlignTimeReports aligns time report rows fetched for several periods,
+// returning one series per key ordered by key. It is the pure step behind
+// TimeReportTrend and can be fed recorded results for golden testing.
+//
+// Experimental: this API may change in minor releases.
+func AlignTimeReports(periods [][]TimeReport) []TimeReportSeries {
+	keys, values := alignPeriods(periods, TimeReport.Key, TimeReport.withoutTotals, compareTimeReportKeys)
+
+	series := make([]TimeReportSeries, len(keys))
+	for i, k := range keys {
+		series[i] = TimeReportSeries{Key: k, Values: values[k]}
+	}
+	return series
+}
+
+// TimeReportComparison is a time report row for the current period alongside
+// the same row for a comparison period.
+type TimeReportComparison struct {
+	Key      TimeReportKey `json:"key"`
+	Current  TimeReport    `json:"current"`
+	Previous TimeReport    `json:"previous"`
+}
+
+// TotalHoursDelta returns the change in total hours between the periods.
+func (c TimeReportComparison) TotalHoursDelta() decimal.Decimal {
+	return c.Current.TotalHours.Sub(c.Previous.TotalHours)
+}
+
+// BillableHoursDelta returns the change in billable hours between the periods.
+func (c TimeReportComparison) BillableHoursDelta() decimal.Decimal {
+	return c.Current.BillableHours.Sub(c.Previous.BillableHours)
+}
+
+// BillableAmountDelta returns the change in billable amount between the periods.
+func (c TimeReportComparison) BillableAmountDelta() decimal.Decimal {
+	return c.Current.BillableAmount.Sub(c.Previous.BillableAmount)
+}
+
+// CompareTimeReports runs the time report for the current and previous
+// periods and returns the rows aligned by key. Use Period.Previous or
+// Period.YearAgo to derive the comparison period.
+//
+// Experimental: this API may change in minor releases.
+func (s *ReportsService) CompareTimeReports(ctx context.Context, opts *TimeReportsOptions, current, previous Period) ([]TimeReportComparison, error) {
+	series, err := s.TimeReportTrend(ctx, opts, []Period{current, previous})
+	if err != nil {
+		return nil, err
+	}
+
+	comparisons := make([]TimeReportComparison, len(series))
+	for i, row := range series {
+		comparisons[i] = TimeReportComparison{
+			Key:      row.Key,
+			Current:  row.Values[0],
+			Previous: row.Values[1],
+		}
+	}
+	return comparisons, nil
+}
+
+// ExpenseReportKey identifies an expense report row across periods.
+// Harvest reports one row per currency, so the currency is part of the key.
+type ExpenseReportKey struct {
+	ClientID          ClientID          `json:"client_id,omitempty"`
+	ProjectID         ProjectID         `json:"project_id,omitempty"`
+	ExpenseCategoryID ExpenseCategoryID `json:"expense_category_id,omitempty"`
+	UserID            UserID            `json:"user_id,omitempty"`
+	Currency          string            `json:"currency"`
+}
+
+// Key returns the key identifying r across periods.
+func (r ExpenseReport) Key() ExpenseReportKey {
+	return ExpenseReportKey{
+		ClientID:          r.ClientID,
+		ProjectID:         r.ProjectID,
+		ExpenseCategoryID: r.ExpenseCategoryID,
+		UserID:            r.UserID,
+		Currency:          r.Currency,
+	}
+}
+
+func compareExpenseReportKeys(a, b ExpenseReportKey) int {
+	return cmp.Or(
+		cmp.Compare(a.ClientID, b.ClientID),
+		cmp.Compare(a.ProjectID, b.ProjectID),
+		cmp.Compare(a.ExpenseCategoryID, b.ExpenseCategoryID),
+		cmp.Compare(a.UserID, b.UserID),
+		cmp.Compare(a.Currency, b.Currency),
+	)
+}
+
+// withoutTotals returns r with its identifying fields intact and all totals zeroed.
+func (r ExpenseReport) withoutTotals() ExpenseReport {
+	r.TotalAmount = decimal.Zero
+	r.BillableAmount = decimal.Zero
+	return r
+}
+
+// ExpenseReportSeries is a single expense report row aligned across several periods.
+// Values has one entry per requested period; periods in which the row did not
+// appear hold a zero-total entry.
+type ExpenseReportSeries struct {
+	Key    ExpenseReportKey `json:"key"`
+	Values []ExpenseReport  `json:"values"`
+}
+
+// ExpenseReportTrend runs the expense report described by opts once per period
+// and returns the rows aligned across periods, ordered by key.
+// The From and To fields of opts are ignored.
+//
+// Experimental: this API may change in minor releases.
+func (s *ReportsService) ExpenseReportTrend(ctx context.Context, opts *ExpenseReportsOptions, periods []Period) ([]ExpenseReportSeries, error) {
+	results := make([][]ExpenseReport, len(periods))
+	for i, p := range periods {
+		o := ExpenseReportsOptions{}
+		if opts != nil {
+			o = *opts
+		}
+		o.From = p.From.String()
+		o.To = p.To.String()
+
+		rows, err := collect(s.AllExpenseReports(ctx, &o))
+		if err != nil {
+			return nil, err
+		}
+		results[i] = rows
+	}
+
+	return AlignExpenseReports(results), nil
+}
+
+// AlignExpenseReports aligns expense report rows fetched for several periods,
+// returning one series per key ordered by key. It is the pure step behind
+// ExpenseReportTrend and can be fed recorded results for golden testing.
+//
+// Experimental: this API may change in minor releases.
+func AlignExpenseReports(periods [][]ExpenseReport) []ExpenseReportSeries {
+	keys, values := alignPeriods(periods, ExpenseReport.Key, ExpenseReport.withoutTotals, compareExpenseReportKeys)
+
+	series := make([]ExpenseReportSeries, len(keys))
+	for i, k := range keys {
+		series[i] = ExpenseReportSeries{Key: k, Values: values[k]}
+	}
+	return series
+}
+
+// ExpenseReportComparison is an expense report row for the current period
+// alongside the same row for a comparison period.
+type ExpenseReportComparison struct {
+	Key      ExpenseReportKey `json:"key"`
+	Current  ExpenseReport    `json:"current"`
+	Previous ExpenseReport    `json:"previous"`
+}
+
+// TotalAmountDelta returns the change in total amount between the periods.
+func (c ExpenseReportComparison) TotalAmountDelta() decimal.Decimal {
+	return c.Current.TotalAmount.Sub(c.Previous.TotalAmount)
+}
+
+// BillableAmountDelta returns the change in billable amount between the periods.
+func (c ExpenseReportComparison) BillableAmountDelta() decimal.Decimal {
+	return c.Current.BillableAmount.Sub(c.Previous.BillableAmount)
+}
+
+// CompareExpenseReports runs the expense report for the current and previous
+// periods and returns the rows aligned by key.
+//
+// Experimental: this API may change in minor releases.
+func (s *ReportsService) CompareExpenseReports(ctx context.Context, opts *ExpenseReportsOptions, current, previous Period) ([]ExpenseReportComparison, error) {
+	series, err := s.ExpenseReportTrend(ctx, opts, []Period{current, previous})
+	if err != nil {
+		return nil, err
+	}
+
+	comparisons := make([]ExpenseReportComparison, len(series))
+	for i, row := range series {
+		comparisons[i] = ExpenseReportComparison{
+			Key:      row.Key,
+			Current:  row.Values[0],
+			Previous: row.Values[1],
+		}
+	}
+	return comparisons, nil
+}
+
+// alignPeriods aligns rows from several periods by key. It returns the keys
+// sorted with compare and, for each key, one row per period. Periods missing
+// a row are filled from another period's row passed through blank.
+func alignPeriods[R any, K comparable](periods [][]R, key func(R) K, blank func(R) R, compare func(a, b K) int) ([]K, map[K][]R) {
+	var keys []K
+	values := make(map[K][]R)
+	present := make(map[K][]bool)
+
+	for i, rows := range periods {
+		for _, row := range rows {
+			k := key(row)
+			if _, ok := values[k]; !ok {
+				keys = append(keys, k)
+				values[k] = make([]R, len(periods))
+				present[k] = make([]bool, len(periods))
+			}
+			values[k][i] = row
+			present[k][i] = true
+		}
+	}
+
+	for _, k := range keys {
+		var template R
+		for i, ok := range present[k] {
+			if ok {
+				template = values[k][i]
+				break
+			}
+		}
+		for i, ok := range present[k] {
+			if !ok {
+				values[k][i] = blank(template)
+			}
+		}
+	}
+
+	slices.SortFunc(keys, compare)
+	return keys, values
+}
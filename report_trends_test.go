@@ -0,0 +1,95 @@
+package harvest
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/joefitzgerald/harvest/fixtures"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+// checkGolden compares got with testdata/name, rewriting the file when -update is set.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s mismatch (run go test -update to accept)\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+func goldenName(fixture string) string {
+	return strings.TrimSuffix(fixture, ".json") + ".golden"
+}
+
+// The second period drops the first row so every golden file covers a row
+// that is missing from one period.
+func TestAlignTimeReportsGolden(t *testing.T) {
+	for _, name := range []string{fixtures.TimeReportMixedCurrencies, fixtures.TimeReportContractors} {
+		t.Run(name, func(t *testing.T) {
+			var page TimeReportResults
+			if err := fixtures.Load(name, &page); err != nil {
+				t.Fatal(err)
+			}
+			if len(page.Results) == 0 {
+				t.Fatal("fixture has no rows")
+			}
+
+			var buf bytes.Buffer
+			series := AlignTimeReports([][]TimeReport{page.Results, page.Results[1:]})
+			if err := WriteSnapshot(&buf, "time_report_trend", series); err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, goldenName(name), buf.Bytes())
+		})
+	}
+}
+
+func TestAlignExpenseReportsGolden(t *testing.T) {
+	name := fixtures.ExpenseReportMixedCurrencies
+	var page ExpenseReportResults
+	if err := fixtures.Load(name, &page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results) == 0 {
+		t.Fatal("fixture has no rows")
+	}
+
+	var buf bytes.Buffer
+	series := AlignExpenseReports([][]ExpenseReport{page.Results, page.Results[1:]})
+	if err := WriteSnapshot(&buf, "expense_report_trend", series); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, goldenName(name), buf.Bytes())
+}
+
+func TestProjectBudgetReportGolden(t *testing.T) {
+	name := fixtures.ProjectBudgetMonthly
+	var page ProjectBudgetReportResults
+	if err := fixtures.Load(name, &page); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, "project_budget_report", page.Results); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, goldenName(name), buf.Bytes())
+}
@@ -2,6 +2,8 @@ package harvest
 
 import (
 	"context"
+	"iter"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
@@ -12,31 +14,64 @@ type ReportsService struct {
 	client *API
 }
 
+// ReportResults is a page of report results.
+type ReportResults[T any] struct {
+	Results []T `json:"results"`
+	Paginated[T]
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Results and Items.
+func (r *ReportResults[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "results", &r.Paginated, &r.Results)
+}
+
+func (r *ReportResults[T]) page() *Paginated[T] {
+	r.Results = r.Items
+	return &r.Paginated
+}
+
+// getReport fetches a page of report results from path.
+func getReport[T any](ctx context.Context, c *API, path string, opts any) (*ReportResults[T], error) {
+	return listPage[T, ReportResults[T]](ctx, c, path, opts)
+}
+
+// allReports returns an iterator over every row of a report, starting at
+// *page and defaulting *page and *perPage like the list endpoints do.
+func allReports[T any](ctx context.Context, c *API, page, perPage *int, fetch func(ctx context.Context, page int) (*ReportResults[T], error)) iter.Seq2[T, error] {
+	if *page == 0 {
+		*page = 1
+	}
+	if *perPage == 0 {
+		*perPage = DefaultPerPage
+	}
+	return all[T](ctx, c, ListOptions{Page: *page, PerPage: *perPage}, fetch)
+}
+
 // TimeReportsOptions specifies optional parameters for time reports.
 type TimeReportsOptions struct {
-	From           string `url:"from"`
-	To             string `url:"to"`
-	ClientID       int64  `url:"client_id,omitempty"`
-	ProjectID      int64  `url:"project_id,omitempty"`
-	TaskID         int64  `url:"task_id,omitempty"`
-	UserID         int64  `url:"user_id,omitempty"`
-	IsBilled       *bool  `url:"is_billed,omitempty"`
-	IsRunning      *bool  `url:"is_running,omitempty"`
-	OnlyBillable   *bool  `url:"only_billable,omitempty"`
-	OnlyUnbillable *bool  `url:"only_unbillable,omitempty"`
-	Page           int    `url:"page,omitempty"`
-	PerPage        int    `url:"per_page,omitempty"`
+	From           string    `url:"from"`
+	To             string    `url:"to"`
+	ClientID       ClientID  `url:"client_id,omitempty"`
+	ProjectID      ProjectID `url:"project_id,omitempty"`
+	TaskID         TaskID    `url:"task_id,omitempty"`
+	UserID         UserID    `url:"user_id,omitempty"`
+	IsBilled       *bool     `url:"is_billed,omitempty"`
+	IsRunning      *bool     `url:"is_running,omitempty"`
+	OnlyBillable   *bool     `url:"only_billable,omitempty"`
+	OnlyUnbillable *bool     `url:"only_unbillable,omitempty"`
+	Page           int       `url:"page,omitempty"`
+	PerPage        int       `url:"per_page,omitempty"`
 }
 
 // TimeReport represents a time report entry.
 type TimeReport struct {
-	ClientID       int64           `json:"client_id"`
+	ClientID       ClientID        `json:"client_id"`
 	ClientName     string          `json:"client_name"`
-	ProjectID      int64           `json:"project_id"`
+	ProjectID      ProjectID       `json:"project_id"`
 	ProjectName    string          `json:"project_name"`
-	TaskID         int64           `json:"task_id"`
+	TaskID         TaskID          `json:"task_id"`
 	TaskName       string          `json:"task_name"`
-	UserID         int64           `json:"user_id"`
+	UserID         UserID          `json:"user_id"`
 	UserName       string          `json:"user_name"`
 	WeeklyCapacity int             `json:"weekly_capacity"`
 	AvatarURL      string          `json:"avatar_url"`
@@ -48,114 +83,261 @@ type TimeReport struct {
 }
 
 // TimeReportResults represents time report results.
-type TimeReportResults struct {
-	Results      []TimeReport     `json:"results"`
-	PerPage      int              `json:"per_page"`
-	TotalPages   int              `json:"total_pages"`
-	TotalEntries int              `json:"total_entries"`
-	NextPage     *int             `json:"next_page"`
-	PreviousPage *int             `json:"previous_page"`
-	Page         int              `json:"page"`
-	Links        *PaginationLinks `json:"links"`
-}
+type TimeReportResults = ReportResults[TimeReport]
 
 // TimeReports retrieves time reports.
 func (s *ReportsService) TimeReports(ctx context.Context, opts *TimeReportsOptions) (*TimeReportResults, error) {
-	u, err := addOptions("reports/time/team", opts)
-	if err != nil {
-		return nil, err
+	return getReport[TimeReport](ctx, s.client, "reports/time/team", opts)
+}
+
+// AllTimeReports returns an iterator over all time report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllTimeReports(ctx context.Context, opts *TimeReportsOptions) iter.Seq2[TimeReport, error] {
+	if opts == nil {
+		opts = &TimeReportsOptions{}
 	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[TimeReport], error) {
+		o := *opts
+		o.Page = page
+		return s.TimeReports(ctx, &o)
+	})
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
+// TimeClientReport is a time report row grouped by client.
+type TimeClientReport struct {
+	ClientID       ClientID        `json:"client_id"`
+	ClientName     string          `json:"client_name"`
+	TotalHours     decimal.Decimal `json:"total_hours"`
+	BillableHours  decimal.Decimal `json:"billable_hours"`
+	Currency       string          `json:"currency"`
+	BillableAmount decimal.Decimal `json:"billable_amount"`
+}
+
+// TimeProjectReport is a time report row grouped by project.
+type TimeProjectReport struct {
+	ClientID       ClientID        `json:"client_id"`
+	ClientName     string          `json:"client_name"`
+	ProjectID      ProjectID       `json:"project_id"`
+	ProjectName    string          `json:"project_name"`
+	TotalHours     decimal.Decimal `json:"total_hours"`
+	BillableHours  decimal.Decimal `json:"billable_hours"`
+	Currency       string          `json:"currency"`
+	BillableAmount decimal.Decimal `json:"billable_amount"`
+}
+
+// TimeTaskReport is a time report row grouped by task.
+type TimeTaskReport struct {
+	TaskID         TaskID          `json:"task_id"`
+	TaskName       string          `json:"task_name"`
+	TotalHours     decimal.Decimal `json:"total_hours"`
+	BillableHours  decimal.Decimal `json:"billable_hours"`
+	Currency       string          `json:"currency"`
+	BillableAmount decimal.Decimal `json:"billable_amount"`
+}
+
+// TimeClientReports retrieves time reports grouped by client.
+func (s *ReportsService) TimeClientReports(ctx context.Context, opts *TimeReportsOptions) (*ReportResults[TimeClientReport], error) {
+	return getReport[TimeClientReport](ctx, s.client, "reports/time/clients", opts)
+}
+
+// AllTimeClientReports returns an iterator over all client time report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllTimeClientReports(ctx context.Context, opts *TimeReportsOptions) iter.Seq2[TimeClientReport, error] {
+	if opts == nil {
+		opts = &TimeReportsOptions{}
 	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[TimeClientReport], error) {
+		o := *opts
+		o.Page = page
+		return s.TimeClientReports(ctx, &o)
+	})
+}
+
+// TimeProjectReports retrieves time reports grouped by project.
+func (s *ReportsService) TimeProjectReports(ctx context.Context, opts *TimeReportsOptions) (*ReportResults[TimeProjectReport], error) {
+	return getReport[TimeProjectReport](ctx, s.client, "reports/time/projects", opts)
+}
 
-	var report TimeReportResults
-	_, err = s.client.Do(ctx, req, &report)
-	if err != nil {
-		return nil, err
+// AllTimeProjectReports returns an iterator over all project time report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllTimeProjectReports(ctx context.Context, opts *TimeReportsOptions) iter.Seq2[TimeProjectReport, error] {
+	if opts == nil {
+		opts = &TimeReportsOptions{}
 	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[TimeProjectReport], error) {
+		o := *opts
+		o.Page = page
+		return s.TimeProjectReports(ctx, &o)
+	})
+}
+
+// TimeTaskReports retrieves time reports grouped by task.
+func (s *ReportsService) TimeTaskReports(ctx context.Context, opts *TimeReportsOptions) (*ReportResults[TimeTaskReport], error) {
+	return getReport[TimeTaskReport](ctx, s.client, "reports/time/tasks", opts)
+}
 
-	return &report, nil
+// AllTimeTaskReports returns an iterator over all task time report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllTimeTaskReports(ctx context.Context, opts *TimeReportsOptions) iter.Seq2[TimeTaskReport, error] {
+	if opts == nil {
+		opts = &TimeReportsOptions{}
+	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[TimeTaskReport], error) {
+		o := *opts
+		o.Page = page
+		return s.TimeTaskReports(ctx, &o)
+	})
 }
 
 // ExpenseReportsOptions specifies optional parameters for expense reports.
 type ExpenseReportsOptions struct {
-	From      string `url:"from"`
-	To        string `url:"to"`
-	ClientID  int64  `url:"client_id,omitempty"`
-	ProjectID int64  `url:"project_id,omitempty"`
-	UserID    int64  `url:"user_id,omitempty"`
-	IsBilled  *bool  `url:"is_billed,omitempty"`
-	Page      int    `url:"page,omitempty"`
-	PerPage   int    `url:"per_page,omitempty"`
+	From      string    `url:"from"`
+	To        string    `url:"to"`
+	ClientID  ClientID  `url:"client_id,omitempty"`
+	ProjectID ProjectID `url:"project_id,omitempty"`
+	UserID    UserID    `url:"user_id,omitempty"`
+	IsBilled  *bool     `url:"is_billed,omitempty"`
+	Page      int       `url:"page,omitempty"`
+	PerPage   int       `url:"per_page,omitempty"`
 }
 
 // ExpenseReport represents an expense report entry.
 type ExpenseReport struct {
-	ClientID            int64           `json:"client_id"`
-	ClientName          string          `json:"client_name"`
-	ProjectID           int64           `json:"project_id"`
-	ProjectName         string          `json:"project_name"`
-	ExpenseCategoryID   int64           `json:"expense_category_id"`
-	ExpenseCategoryName string          `json:"expense_category_name"`
-	UserID              int64           `json:"user_id"`
-	UserName            string          `json:"user_name"`
-	IsContractor        bool            `json:"is_contractor"`
-	TotalAmount         decimal.Decimal `json:"total_amount"`
-	BillableAmount      decimal.Decimal `json:"billable_amount"`
-	Currency            string          `json:"currency"`
+	ClientID            ClientID          `json:"client_id"`
+	ClientName          string            `json:"client_name"`
+	ProjectID           ProjectID         `json:"project_id"`
+	ProjectName         string            `json:"project_name"`
+	ExpenseCategoryID   ExpenseCategoryID `json:"expense_category_id"`
+	ExpenseCategoryName string            `json:"expense_category_name"`
+	UserID              UserID            `json:"user_id"`
+	UserName            string            `json:"user_name"`
+	IsContractor        bool              `json:"is_contractor"`
+	TotalAmount         decimal.Decimal   `json:"total_amount"`
+	BillableAmount      decimal.Decimal   `json:"billable_amount"`
+	Currency            string            `json:"currency"`
 }
 
 // ExpenseReportResults represents expense report results.
-type ExpenseReportResults struct {
-	Results      []ExpenseReport  `json:"results"`
-	PerPage      int              `json:"per_page"`
-	TotalPages   int              `json:"total_pages"`
-	TotalEntries int              `json:"total_entries"`
-	NextPage     *int             `json:"next_page"`
-	PreviousPage *int             `json:"previous_page"`
-	Page         int              `json:"page"`
-	Links        *PaginationLinks `json:"links"`
-}
+type ExpenseReportResults = ReportResults[ExpenseReport]
 
 // ExpenseReports retrieves expense reports.
 func (s *ReportsService) ExpenseReports(ctx context.Context, opts *ExpenseReportsOptions) (*ExpenseReportResults, error) {
-	u, err := addOptions("reports/expenses/team", opts)
-	if err != nil {
-		return nil, err
+	return getReport[ExpenseReport](ctx, s.client, "reports/expenses/team", opts)
+}
+
+// AllExpenseReports returns an iterator over all expense report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllExpenseReports(ctx context.Context, opts *ExpenseReportsOptions) iter.Seq2[ExpenseReport, error] {
+	if opts == nil {
+		opts = &ExpenseReportsOptions{}
 	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[ExpenseReport], error) {
+		o := *opts
+		o.Page = page
+		return s.ExpenseReports(ctx, &o)
+	})
+}
+
+// ExpenseClientReport is an expense report row grouped by client.
+type ExpenseClientReport struct {
+	ClientID       ClientID        `json:"client_id"`
+	ClientName     string          `json:"client_name"`
+	TotalAmount    decimal.Decimal `json:"total_amount"`
+	BillableAmount decimal.Decimal `json:"billable_amount"`
+	Currency       string          `json:"currency"`
+}
+
+// ExpenseProjectReport is an expense report row grouped by project.
+type ExpenseProjectReport struct {
+	ClientID       ClientID        `json:"client_id"`
+	ClientName     string          `json:"client_name"`
+	ProjectID      ProjectID       `json:"project_id"`
+	ProjectName    string          `json:"project_name"`
+	TotalAmount    decimal.Decimal `json:"total_amount"`
+	BillableAmount decimal.Decimal `json:"billable_amount"`
+	Currency       string          `json:"currency"`
+}
+
+// ExpenseCategoryReport is an expense report row grouped by expense
+// category. Harvest groups expenses by category where time is grouped by
+// task.
+type ExpenseCategoryReport struct {
+	ExpenseCategoryID   ExpenseCategoryID `json:"expense_category_id"`
+	ExpenseCategoryName string            `json:"expense_category_name"`
+	TotalAmount         decimal.Decimal   `json:"total_amount"`
+	BillableAmount      decimal.Decimal   `json:"billable_amount"`
+	Currency            string            `json:"currency"`
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
+// ExpenseClientReports retrieves expense reports grouped by client.
+func (s *ReportsService) ExpenseClientReports(ctx context.Context, opts *ExpenseReportsOptions) (*ReportResults[ExpenseClientReport], error) {
+	return getReport[ExpenseClientReport](ctx, s.client, "reports/expenses/clients", opts)
+}
+
+// AllExpenseClientReports returns an iterator over all client expense report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllExpenseClientReports(ctx context.Context, opts *ExpenseReportsOptions) iter.Seq2[ExpenseClientReport, error] {
+	if opts == nil {
+		opts = &ExpenseReportsOptions{}
 	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[ExpenseClientReport], error) {
+		o := *opts
+		o.Page = page
+		return s.ExpenseClientReports(ctx, &o)
+	})
+}
 
-	var report ExpenseReportResults
-	_, err = s.client.Do(ctx, req, &report)
-	if err != nil {
-		return nil, err
+// ExpenseProjectReports retrieves expense reports grouped by project.
+func (s *ReportsService) ExpenseProjectReports(ctx context.Context, opts *ExpenseReportsOptions) (*ReportResults[ExpenseProjectReport], error) {
+	return getReport[ExpenseProjectReport](ctx, s.client, "reports/expenses/projects", opts)
+}
+
+// AllExpenseProjectReports returns an iterator over all project expense report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllExpenseProjectReports(ctx context.Context, opts *ExpenseReportsOptions) iter.Seq2[ExpenseProjectReport, error] {
+	if opts == nil {
+		opts = &ExpenseReportsOptions{}
 	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[ExpenseProjectReport], error) {
+		o := *opts
+		o.Page = page
+		return s.ExpenseProjectReports(ctx, &o)
+	})
+}
 
-	return &report, nil
+// ExpenseCategoryReports retrieves expense reports grouped by expense category.
+func (s *ReportsService) ExpenseCategoryReports(ctx context.Context, opts *ExpenseReportsOptions) (*ReportResults[ExpenseCategoryReport], error) {
+	return getReport[ExpenseCategoryReport](ctx, s.client, "reports/expenses/categories", opts)
+}
+
+// AllExpenseCategoryReports returns an iterator over all expense category report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllExpenseCategoryReports(ctx context.Context, opts *ExpenseReportsOptions) iter.Seq2[ExpenseCategoryReport, error] {
+	if opts == nil {
+		opts = &ExpenseReportsOptions{}
+	}
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[ExpenseCategoryReport], error) {
+		o := *opts
+		o.Page = page
+		return s.ExpenseCategoryReports(ctx, &o)
+	})
 }
 
 // UninvoicedReportOptions specifies optional parameters for uninvoiced reports.
 type UninvoicedReportOptions struct {
-	From      string `url:"from"`
-	To        string `url:"to"`
-	ClientID  int64  `url:"client_id,omitempty"`
-	ProjectID int64  `url:"project_id,omitempty"`
-	Page      int    `url:"page,omitempty"`
-	PerPage   int    `url:"per_page,omitempty"`
+	From      string    `url:"from"`
+	To        string    `url:"to"`
+	ClientID  ClientID  `url:"client_id,omitempty"`
+	ProjectID ProjectID `url:"project_id,omitempty"`
+	Page      int       `url:"page,omitempty"`
+	PerPage   int       `url:"per_page,omitempty"`
 }
 
 // UninvoicedReport represents an uninvoiced report entry.
 type UninvoicedReport struct {
-	ClientID           int64           `json:"client_id"`
+	ClientID           ClientID        `json:"client_id"`
 	ClientName         string          `json:"client_name"`
-	ProjectID          int64           `json:"project_id"`
+	ProjectID          ProjectID       `json:"project_id"`
 	ProjectName        string          `json:"project_name"`
 	Currency           string          `json:"currency"`
 	TotalHours         decimal.Decimal `json:"total_hours"`
@@ -165,93 +347,70 @@ type UninvoicedReport struct {
 }
 
 // UninvoicedReportResults represents uninvoiced report results.
-type UninvoicedReportResults struct {
-	Results      []UninvoicedReport `json:"results"`
-	PerPage      int                `json:"per_page"`
-	TotalPages   int                `json:"total_pages"`
-	TotalEntries int                `json:"total_entries"`
-	NextPage     *int               `json:"next_page"`
-	PreviousPage *int               `json:"previous_page"`
-	Page         int                `json:"page"`
-	Links        *PaginationLinks   `json:"links"`
-}
+type UninvoicedReportResults = ReportResults[UninvoicedReport]
 
 // UninvoicedReports retrieves uninvoiced reports.
 func (s *ReportsService) UninvoicedReports(ctx context.Context, opts *UninvoicedReportOptions) (*UninvoicedReportResults, error) {
-	u, err := addOptions("reports/uninvoiced", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+	return getReport[UninvoicedReport](ctx, s.client, "reports/uninvoiced", opts)
+}
 
-	var report UninvoicedReportResults
-	_, err = s.client.Do(ctx, req, &report)
-	if err != nil {
-		return nil, err
+// AllUninvoicedReports returns an iterator over all uninvoiced report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllUninvoicedReports(ctx context.Context, opts *UninvoicedReportOptions) iter.Seq2[UninvoicedReport, error] {
+	if opts == nil {
+		opts = &UninvoicedReportOptions{}
 	}
-
-	return &report, nil
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[UninvoicedReport], error) {
+		o := *opts
+		o.Page = page
+		return s.UninvoicedReports(ctx, &o)
+	})
 }
 
 // ProjectBudgetReportOptions specifies optional parameters for project budget reports.
 type ProjectBudgetReportOptions struct {
-	Page         int    `url:"page,omitempty"`
-	PerPage      int    `url:"per_page,omitempty"`
-	IsActive     *bool  `url:"is_active,omitempty"`
-	ClientID     int64  `url:"client_id,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	Page         int        `url:"page,omitempty"`
+	PerPage      int        `url:"per_page,omitempty"`
+	IsActive     *bool      `url:"is_active,omitempty"`
+	ClientID     ClientID   `url:"client_id,omitempty"`
+	UpdatedSince *time.Time `url:"updated_since,omitempty"`
 }
 
 // ProjectBudgetReport represents a project budget report entry.
 type ProjectBudgetReport struct {
-	ClientID         int64            `json:"client_id"`
+	ClientID         ClientID         `json:"client_id"`
 	ClientName       string           `json:"client_name"`
-	ProjectID        int64            `json:"project_id"`
+	ProjectID        ProjectID        `json:"project_id"`
 	ProjectName      string           `json:"project_name"`
 	ProjectCode      string           `json:"project_code"`
 	ProjectStartDate *Date            `json:"project_start_date"`
 	ProjectEndDate   *Date            `json:"project_end_date"`
 	IsBillable       bool             `json:"is_billable"`
 	IsActive         bool             `json:"is_active"`
-	BudgetBy         string           `json:"budget_by"`
+	BudgetIsMonthly  bool             `json:"budget_is_monthly"`
+	BudgetBy         BudgetBy         `json:"budget_by"`
 	Budget           *decimal.Decimal `json:"budget"`
 	BudgetSpent      decimal.Decimal  `json:"budget_spent"`
 	BudgetRemaining  *decimal.Decimal `json:"budget_remaining"`
 }
 
 // ProjectBudgetReportResults represents project budget report results.
-type ProjectBudgetReportResults struct {
-	Results      []ProjectBudgetReport `json:"results"`
-	PerPage      int                   `json:"per_page"`
-	TotalPages   int                   `json:"total_pages"`
-	TotalEntries int                   `json:"total_entries"`
-	NextPage     *int                  `json:"next_page"`
-	PreviousPage *int                  `json:"previous_page"`
-	Page         int                   `json:"page"`
-	Links        *PaginationLinks      `json:"links"`
-}
+type ProjectBudgetReportResults = ReportResults[ProjectBudgetReport]
 
 // ProjectBudgetReports retrieves project budget reports.
 func (s *ReportsService) ProjectBudgetReports(ctx context.Context, opts *ProjectBudgetReportOptions) (*ProjectBudgetReportResults, error) {
-	u, err := addOptions("reports/project_budget", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+	return getReport[ProjectBudgetReport](ctx, s.client, "reports/project_budget", opts)
+}
 
-	var report ProjectBudgetReportResults
-	_, err = s.client.Do(ctx, req, &report)
-	if err != nil {
-		return nil, err
+// AllProjectBudgetReports returns an iterator over all project budget report rows, fetching pages as
+// the loop advances. Breaking out of the loop stops further requests.
+func (s *ReportsService) AllProjectBudgetReports(ctx context.Context, opts *ProjectBudgetReportOptions) iter.Seq2[ProjectBudgetReport, error] {
+	if opts == nil {
+		opts = &ProjectBudgetReportOptions{}
 	}
-
-	return &report, nil
+	return allReports(ctx, s.client, &opts.Page, &opts.PerPage, func(ctx context.Context, page int) (*ReportResults[ProjectBudgetReport], error) {
+		o := *opts
+		o.Page = page
+		return s.ProjectBudgetReports(ctx, &o)
+	})
 }
@@ -0,0 +1,86 @@
+package harvest
+
+import (
+	"context"
+	"slices"
+)
+
+// RoleMembershipChanges is what a role membership helper changed.
+type RoleMembershipChanges struct {
+	Role    *Role
+	Added   []UserID
+	Removed []UserID
+}
+
+// AddUsers adds users to a role, keeping its other members. The role is
+// only updated when some of the users aren't members yet.
+func (s *RolesService) AddUsers(ctx context.Context, roleID RoleID, userIDs []UserID) (*RoleMembershipChanges, error) {
+	return s.updateMembers(ctx, roleID, func(current []UserID) []UserID {
+		members := slices.Clone(current)
+		for _, id := range userIDs {
+			if !slices.Contains(members, id) {
+				members = append(members, id)
+			}
+		}
+		return members
+	})
+}
+
+// RemoveUsers removes users from a role, keeping its other members. The
+// role is only updated when some of the users are members.
+func (s *RolesService) RemoveUsers(ctx context.Context, roleID RoleID, userIDs []UserID) (*RoleMembershipChanges, error) {
+	return s.updateMembers(ctx, roleID, func(current []UserID) []UserID {
+		return slices.DeleteFunc(slices.Clone(current), func(id UserID) bool { return slices.Contains(userIDs, id) })
+	})
+}
+
+// SyncMembers makes desired the role's members and reports who was added
+// and removed. The role is only updated when its members differ.
+func (s *RolesService) SyncMembers(ctx context.Context, roleID RoleID, desired []UserID) (*RoleMembershipChanges, error) {
+	return s.updateMembers(ctx, roleID, func([]UserID) []UserID {
+		var members []UserID
+		for _, id := range desired {
+			if !slices.Contains(members, id) {
+				members = append(members, id)
+			}
+		}
+		return members
+	})
+}
+
+// updateMembers reads the role's members, computes the new members with
+// change, and writes them back when they differ. Harvest only supports
+// replacing all members, so a concurrent change between the read and the
+// write is lost; keeping the window to one read and at most one write
+// makes that unlikely.
+func (s *RolesService) updateMembers(ctx context.Context, roleID RoleID, change func(current []UserID) []UserID) (*RoleMembershipChanges, error) {
+	role, err := s.Get(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	members := change(role.UserIDs)
+
+	changes := &RoleMembershipChanges{Role: role}
+	for _, id := range members {
+		if !slices.Contains(role.UserIDs, id) {
+			changes.Added = append(changes.Added, id)
+		}
+	}
+	for _, id := range role.UserIDs {
+		if !slices.Contains(members, id) {
+			changes.Removed = append(changes.Removed, id)
+		}
+	}
+	if len(changes.Added) == 0 && len(changes.Removed) == 0 {
+		return changes, nil
+	}
+
+	if members == nil {
+		members = []UserID{}
+	}
+	changes.Role, err = s.Update(ctx, roleID, &RoleUpdateRequest{UserIDs: members})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
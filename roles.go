@@ -3,6 +3,7 @@ package harvest
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // RolesService handles communication with the role related
@@ -22,32 +23,40 @@ type RoleList struct {
 	Paginated[Role]
 }
 
+func (l *RoleList) page() *Paginated[Role] {
+	l.Roles = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Roles and Items.
+func (l *RoleList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "roles", &l.Paginated, &l.Roles)
+}
+
 // ListPage returns a single page of roles.
 func (s *RolesService) ListPage(ctx context.Context, opts *RoleListOptions) (*RoleList, error) {
-	u, err := addOptions("roles", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Role, RoleList](ctx, s.client, "roles", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all roles across all pages.
+func (s *RolesService) List(ctx context.Context, opts *RoleListOptions) ([]Role, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var roles RoleList
-	_, err = s.client.Do(ctx, req, &roles)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *RolesService) ListWithTotals(ctx context.Context, opts *RoleListOptions) (*ListResult[Role], error) {
+	if opts == nil {
+		opts = &RoleListOptions{}
 	}
-
-	// Copy roles to Items for pagination
-	roles.Items = roles.Roles
-
-	return &roles, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Role, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all roles across all pages.
-func (s *RolesService) List(ctx context.Context, opts *RoleListOptions) ([]Role, error) {
+// All returns an iterator over all roles, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *RolesService) All(ctx context.Context, opts *RoleListOptions) iter.Seq2[Role, error] {
 	if opts == nil {
 		opts = &RoleListOptions{}
 	}
@@ -58,35 +67,41 @@ func (s *RolesService) List(ctx context.Context, opts *RoleListOptions) ([]Role,
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allRoles []Role
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allRoles = append(allRoles, result.Roles...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Role](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*RoleList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all roles on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *RolesService) Stream(ctx context.Context, opts *RoleListOptions) (<-chan Role, <-chan error) {
+	if opts == nil {
+		opts = &RoleListOptions{}
 	}
-
-	return allRoles, nil
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // Get retrieves a specific role.
-func (s *RolesService) Get(ctx context.Context, roleID int64) (*Role, error) {
+func (s *RolesService) Get(ctx context.Context, roleID RoleID) (*Role, error) {
 	return Get[Role](ctx, s.client, fmt.Sprintf("roles/%d", roleID))
 }
 
 // RoleCreateRequest represents a request to create a role.
 type RoleCreateRequest struct {
-	Name    string  `json:"name"`
-	UserIDs []int64 `json:"user_ids,omitempty"`
+	Name    string   `json:"name"`
+	UserIDs []UserID `json:"user_ids,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *RoleCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Name != "", "name", "is required")
+	return errs.err()
 }
 
 // Create creates a new role.
@@ -94,18 +109,20 @@ func (s *RolesService) Create(ctx context.Context, role *RoleCreateRequest) (*Ro
 	return Create[Role](ctx, s.client, "roles", role)
 }
 
-// RoleUpdateRequest represents a request to update a role.
+// RoleUpdateRequest represents a request to update a role. UserIDs
+// replaces the role's members; nil leaves them unchanged and an empty
+// slice removes them all.
 type RoleUpdateRequest struct {
-	Name    string  `json:"name,omitempty"`
-	UserIDs []int64 `json:"user_ids,omitempty"`
+	Name    string   `json:"name,omitempty"`
+	UserIDs []UserID `json:"user_ids,omitzero"`
 }
 
 // Update updates a role.
-func (s *RolesService) Update(ctx context.Context, roleID int64, role *RoleUpdateRequest) (*Role, error) {
+func (s *RolesService) Update(ctx context.Context, roleID RoleID, role *RoleUpdateRequest) (*Role, error) {
 	return Update[Role](ctx, s.client, fmt.Sprintf("roles/%d", roleID), role)
 }
 
 // Delete deletes a role.
-func (s *RolesService) Delete(ctx context.Context, roleID int64) error {
+func (s *RolesService) Delete(ctx context.Context, roleID RoleID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("roles/%d", roleID))
 }
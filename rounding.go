@@ -0,0 +1,77 @@
+package harvest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode is how TimeRounding rounds hours to its increment.
+type RoundingMode string
+
+const (
+	// RoundNearest rounds to the nearest increment, halves up.
+	RoundNearest RoundingMode = "nearest"
+	// RoundUp rounds up to the next increment.
+	RoundUp RoundingMode = "up"
+)
+
+// TimeRounding replicates the time rounding configured in an account's
+// settings, which Harvest applies to each entry to produce its
+// rounded_hours. The API doesn't expose the setting, so set Increment and
+// Mode to match Settings > Time rounding; Harvest offers increments of 6,
+// 15 and 30 minutes.
+//
+// Experimental: this API may change in minor releases.
+type TimeRounding struct {
+	// Increment is the step hours are rounded to. Zero disables rounding.
+	Increment time.Duration
+	Mode      RoundingMode
+}
+
+// Validate reports whether r is a rounding Harvest could be configured
+// with.
+func (r TimeRounding) Validate() error {
+	if r.Increment < 0 || r.Increment%time.Minute != 0 {
+		return fmt.Errorf("harvest: rounding increment %s must be a whole number of minutes", r.Increment)
+	}
+	if r.Mode != RoundNearest && r.Mode != RoundUp {
+		return fmt.Errorf("harvest: unknown rounding mode %q", r.Mode)
+	}
+	return nil
+}
+
+// Round rounds hours to the increment. Hours are first rounded to the
+// minute, as Harvest tracks time, so that stray fractions of a second
+// don't push an entry up a whole increment.
+func (r TimeRounding) Round(hours decimal.Decimal) decimal.Decimal {
+	if r.Increment <= 0 {
+		return hours
+	}
+	minutes := hours.Mul(decimal.NewFromInt(60)).Round(0)
+	step := decimal.NewFromInt(int64(r.Increment / time.Minute))
+	steps := minutes.Div(step)
+	if r.Mode == RoundUp {
+		steps = steps.Ceil()
+	} else {
+		steps = steps.Round(0)
+	}
+	return steps.Mul(step).DivRound(decimal.NewFromInt(60), 2)
+}
+
+// RoundEntry returns the rounded hours of a time entry.
+func (r TimeRounding) RoundEntry(e *TimeEntry) decimal.Decimal {
+	return r.Round(e.Hours)
+}
+
+// Total returns the sum of the entries' rounded hours. Harvest rounds each
+// entry separately, so this can be more than the rounded sum of their
+// hours.
+func (r TimeRounding) Total(entries []TimeEntry) decimal.Decimal {
+	var total decimal.Decimal
+	for i := range entries {
+		total = total.Add(r.RoundEntry(&entries[i]))
+	}
+	return total
+}
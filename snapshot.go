@@ -0,0 +1,60 @@
+package harvest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// SnapshotVersion identifies the layout written by WriteSnapshot. It is
+// incremented whenever the serialized form of report helper output changes,
+// so golden files can be regenerated deliberately rather than by accident.
+const SnapshotVersion = 1
+
+// Snapshot wraps report helper output with the metadata needed to compare
+// it against a golden file.
+type Snapshot struct {
+	Version int    `json:"version"`
+	Kind    string `json:"kind"`
+	Data    any    `json:"data"`
+}
+
+// WriteSnapshot writes v to w as indented JSON suitable for golden-file
+// comparison. Output is deterministic for the report helper types: series
+// are ordered by key, map keys are sorted, and decimals are written in their
+// canonical string form.
+//
+// Experimental: this API may change in minor releases.
+func WriteSnapshot(w io.Writer, kind string, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(Snapshot{
+		Version: SnapshotVersion,
+		Kind:    kind,
+		Data:    v,
+	})
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot from r and decodes
+// its data into v. It fails if the snapshot is not of the given kind or was
+// written with a different SnapshotVersion.
+//
+// Experimental: this API may change in minor releases.
+func ReadSnapshot(r io.Reader, kind string, v any) error {
+	var snap struct {
+		Version int             `json:"version"`
+		Kind    string          `json:"kind"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(r).Decode(&snap); err != nil {
+		return err
+	}
+	if snap.Kind != kind {
+		return fmt.Errorf("harvest: snapshot kind is %q, want %q", snap.Kind, kind)
+	}
+	if snap.Version != SnapshotVersion {
+		return fmt.Errorf("harvest: snapshot version %d is not supported, want %d", snap.Version, SnapshotVersion)
+	}
+	return json.Unmarshal(snap.Data, v)
+}
@@ -0,0 +1,31 @@
+package harvest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadSnapshot(t *testing.T) {
+	var buf bytes.Buffer
+	want := []Period{{From: DateOf(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)), To: DateOf(time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC))}}
+	if err := WriteSnapshot(&buf, "periods", want); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []Period
+	if err := ReadSnapshot(bytes.NewReader(buf.Bytes()), "periods", &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].From.String() != "2025-01-06" || got[0].To.String() != "2025-01-12" {
+		t.Errorf("ReadSnapshot = %v, want %v", got, want)
+	}
+
+	if err := ReadSnapshot(bytes.NewReader(buf.Bytes()), "account_settings", &got); err == nil {
+		t.Error("ReadSnapshot accepted a snapshot of another kind")
+	}
+	if err := ReadSnapshot(strings.NewReader(`{"version": 99, "kind": "periods", "data": []}`), "periods", &got); err == nil {
+		t.Error("ReadSnapshot accepted an unsupported version")
+	}
+}
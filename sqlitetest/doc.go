@@ -0,0 +1,4 @@
+// Package sqlitetest tests harvest.SQLiteStore against a real SQLite
+// database. It is a separate module so that the driver, which needs cgo,
+// stays out of the harvest module's dependencies.
+package sqlitetest
@@ -0,0 +1,15 @@
+module github.com/joefitzgerald/harvest/sqlitetest
+
+go 1.25.1
+
+require (
+	github.com/joefitzgerald/harvest v0.0.0
+	github.com/mattn/go-sqlite3 v1.14.33
+)
+
+require (
+	github.com/google/go-querystring v1.1.0 // indirect
+	github.com/shopspring/decimal v1.4.0 // indirect
+)
+
+replace github.com/joefitzgerald/harvest => ../
@@ -0,0 +1,160 @@
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/joefitzgerald/harvest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var _ harvest.StampedStore[harvest.ProjectID, harvest.Project] = (*harvest.SQLiteStore[harvest.ProjectID, harvest.Project])(nil)
+
+func projectID(p harvest.Project) harvest.ProjectID { return p.ID }
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "harvest.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newStore(t *testing.T, db *sql.DB, table string) *harvest.SQLiteStore[harvest.ProjectID, harvest.Project] {
+	t.Helper()
+	s, err := harvest.NewSQLiteStore(context.Background(), db, table, projectID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func names(projects []harvest.Project) []string {
+	var result []string
+	for _, p := range projects {
+		result = append(result, p.Name)
+	}
+	return result
+}
+
+func TestSQLiteStoreUpsertDeleteQuery(t *testing.T) {
+	ctx := context.Background()
+	s := newStore(t, openDB(t), "projects")
+
+	err := s.Upsert(ctx,
+		harvest.Project{ID: 3, Name: "Online Store"},
+		harvest.Project{ID: 1, Name: "Marketing Website"},
+		harvest.Project{ID: 2, Name: "Mobile App"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Query(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Marketing Website", "Mobile App", "Online Store"}; !slices.Equal(names(got), want) {
+		t.Errorf("Query = %q, want %q ordered by ID", names(got), want)
+	}
+
+	// Upserting an existing ID replaces the row.
+	if err := s.Upsert(ctx, harvest.Project{ID: 2, Name: "Mobile App v2"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = s.Query(ctx, func(p harvest.Project) bool { return p.ID >= 2 })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Mobile App v2", "Online Store"}; !slices.Equal(names(got), want) {
+		t.Errorf("Query after upsert = %q, want %q", names(got), want)
+	}
+
+	// Missing IDs are ignored.
+	if err := s.Delete(ctx, 1, 99); err != nil {
+		t.Fatal(err)
+	}
+	got, err = s.Query(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Mobile App v2", "Online Store"}; !slices.Equal(names(got), want) {
+		t.Errorf("Query after delete = %q, want %q", names(got), want)
+	}
+}
+
+func TestSQLiteStoreLoadedAt(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t)
+	projects := newStore(t, db, "projects")
+	clients := newStore(t, db, "clients")
+
+	loadedAt, err := projects.LoadedAt(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loadedAt.IsZero() {
+		t.Errorf("LoadedAt before SetLoadedAt = %v, want zero", loadedAt)
+	}
+
+	want := time.Date(2026, 10, 16, 9, 30, 15, 123456789, time.FixedZone("EST", -5*60*60))
+	if err := projects.SetLoadedAt(ctx, want); err != nil {
+		t.Fatal(err)
+	}
+	if err := projects.SetLoadedAt(ctx, want.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	loadedAt, err = projects.LoadedAt(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loadedAt.Equal(want.Add(time.Hour)) {
+		t.Errorf("LoadedAt = %v, want %v", loadedAt, want.Add(time.Hour))
+	}
+
+	var stored string
+	if err := db.QueryRow("SELECT loaded_at FROM harvest_store_meta WHERE name = 'projects'").Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored != "2026-10-16T15:30:15.123456789Z" {
+		t.Errorf("stored loaded_at = %q, want RFC 3339 in UTC", stored)
+	}
+
+	loadedAt, err = clients.LoadedAt(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loadedAt.IsZero() {
+		t.Errorf("clients LoadedAt = %v, want zero", loadedAt)
+	}
+
+	if err := projects.SetLoadedAt(ctx, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	loadedAt, err = projects.LoadedAt(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loadedAt.IsZero() {
+		t.Errorf("LoadedAt after SetLoadedAt(zero) = %v, want zero", loadedAt)
+	}
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM harvest_store_meta").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("harvest_store_meta has %d rows, want 0", n)
+	}
+}
+
+func TestNewSQLiteStoreRejectsTableName(t *testing.T) {
+	_, err := harvest.NewSQLiteStore(context.Background(), openDB(t), "projects; DROP TABLE x", projectID)
+	if err == nil {
+		t.Error("NewSQLiteStore accepted an invalid table name")
+	}
+}
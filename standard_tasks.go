@@ -0,0 +1,156 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// StandardTask is a task every project should have, with the assignment
+// settings to use. Unset Billable and HourlyRate are left to Harvest on new
+// assignments and unchanged on existing ones.
+type StandardTask struct {
+	Name       string
+	Billable   *bool
+	HourlyRate *decimal.Decimal
+}
+
+// ProjectTaskChanges is what EnsureStandardTasks changed on one project.
+// Tasks are listed by name.
+type ProjectTaskChanges struct {
+	ProjectID   ProjectID
+	ProjectName string
+	Created     []string
+	Updated     []string
+	Deactivated []string
+	// Err is set when reconciling the project failed; the changes listed
+	// were made before the failure.
+	Err error
+}
+
+// EnsureStandardTasks makes every active project's task assignments match
+// standard: the account's tasks are listed once and matched by name like
+// GetOrCreate, missing tasks are created on the account and assigned,
+// inactive or differing assignments are updated, and active assignments of
+// other tasks are deactivated. Requests pause and retry on rate limits like
+// BulkCreate. One result is returned per active project; a failure on one
+// project is recorded in its Err and the others are still reconciled. The
+// error is only set when the tasks or projects can't be looked up.
+//
+// Experimental: this API may change in minor releases.
+func (s *ProjectsService) EnsureStandardTasks(ctx context.Context, standard []StandardTask) ([]ProjectTaskChanges, error) {
+	byName := map[string]Task{}
+	for t, err := range s.client.Tasks.All(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("harvest: listing tasks: %w", err)
+		}
+		key := strings.ToLower(strings.TrimSpace(t.Name))
+		if _, ok := byName[key]; !ok {
+			byName[key] = t
+		}
+	}
+
+	tasks := make(map[TaskID]StandardTask, len(standard))
+	var ids []TaskID
+	for _, st := range standard {
+		name := strings.TrimSpace(st.Name)
+		key := strings.ToLower(name)
+		task, ok := byName[key]
+		if !ok {
+			created, err := s.client.Tasks.Create(ctx, &TaskCreateRequest{Name: name, BillableByDefault: st.Billable, DefaultHourlyRate: st.HourlyRate})
+			if err != nil {
+				return nil, fmt.Errorf("harvest: creating task %q: %w", name, err)
+			}
+			task = *created
+			byName[key] = task
+		}
+		if _, ok := tasks[task.ID]; !ok {
+			ids = append(ids, task.ID)
+		}
+		st.Name = task.Name
+		tasks[task.ID] = st
+	}
+
+	projects, err := s.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o := BulkOptions{Concurrency: 1, MaxRetries: 3}
+	changes := make([]ProjectTaskChanges, len(projects))
+	for i, p := range projects {
+		changes[i] = ProjectTaskChanges{ProjectID: p.ID, ProjectName: p.Name}
+		changes[i].Err = s.ensureStandardTasks(ctx, o, p.ID, ids, tasks, &changes[i])
+	}
+	return changes, nil
+}
+
+// ensureStandardTasks reconciles one project's task assignments, recording
+// what it changes in c.
+func (s *ProjectsService) ensureStandardTasks(ctx context.Context, o BulkOptions, projectID ProjectID, ids []TaskID, tasks map[TaskID]StandardTask, c *ProjectTaskChanges) error {
+	current, err := s.ListTaskAssignments(ctx, projectID, nil)
+	if err != nil {
+		return err
+	}
+	assigned := make(map[TaskID]ProjectTaskAssignment, len(current))
+	for _, a := range current {
+		if a.Task != nil {
+			assigned[a.Task.ID] = a
+		}
+	}
+
+	call := func(do func(context.Context) (*ProjectTaskAssignment, error)) error {
+		_, err := bulkCall(ctx, s.client, o, do)
+		return err
+	}
+	for _, id := range ids {
+		st := tasks[id]
+		a, ok := assigned[id]
+		switch {
+		case !ok:
+			err = call(func(ctx context.Context) (*ProjectTaskAssignment, error) {
+				return s.CreateTaskAssignment(ctx, projectID, &TaskAssignmentCreateRequest{
+					TaskID:     id,
+					IsActive:   Bool(true),
+					Billable:   st.Billable,
+					HourlyRate: st.HourlyRate,
+				})
+			})
+			if err != nil {
+				return fmt.Errorf("harvest: assigning task %q: %w", st.Name, err)
+			}
+			c.Created = append(c.Created, st.Name)
+		case !a.IsActive || (st.Billable != nil && *st.Billable != a.Billable) ||
+			(st.HourlyRate != nil && (a.HourlyRate == nil || !st.HourlyRate.Equal(*a.HourlyRate))):
+			req := &TaskAssignmentUpdateRequest{IsActive: Bool(true), Billable: st.Billable}
+			if st.HourlyRate != nil {
+				req.HourlyRate = Set(*st.HourlyRate)
+			}
+			err = call(func(ctx context.Context) (*ProjectTaskAssignment, error) {
+				return s.UpdateTaskAssignment(ctx, projectID, a.ID, req)
+			})
+			if err != nil {
+				return fmt.Errorf("harvest: updating task %q: %w", st.Name, err)
+			}
+			c.Updated = append(c.Updated, st.Name)
+		}
+	}
+
+	for _, a := range current {
+		if a.Task == nil || !a.IsActive {
+			continue
+		}
+		if _, ok := tasks[a.Task.ID]; ok {
+			continue
+		}
+		err = call(func(ctx context.Context) (*ProjectTaskAssignment, error) {
+			return s.UpdateTaskAssignment(ctx, projectID, a.ID, &TaskAssignmentUpdateRequest{IsActive: Bool(false)})
+		})
+		if err != nil {
+			return fmt.Errorf("harvest: deactivating task %q: %w", a.Task.Name, err)
+		}
+		c.Deactivated = append(c.Deactivated, a.Task.Name)
+	}
+	return nil
+}
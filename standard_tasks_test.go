@@ -0,0 +1,59 @@
+package harvest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestEnsureStandardTasksListsTasksOnce(t *testing.T) {
+	var listed atomic.Int32
+	var created []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/tasks", func(w http.ResponseWriter, r *http.Request) {
+		listed.Add(1)
+		io.WriteString(w, `{"tasks": [{"id": 8083365, "name": "Graphic Design"}, {"id": 8083366, "name": "Programming"}], "page": 1, "total_pages": 1, "total_entries": 2}`)
+	})
+	mux.HandleFunc("POST /v2/tasks", func(w http.ResponseWriter, r *http.Request) {
+		var req TaskCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		created = append(created, req.Name)
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": 8083800, "name": "Research"}`)
+	})
+	mux.HandleFunc("GET /v2/projects", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, projectsPage)
+	})
+	mux.HandleFunc("GET /v2/projects/14307913/task_assignments", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"task_assignments": [{"id": 155505014, "is_active": true, "task": {"id": 8083365, "name": "Graphic Design"}}], "page": 1, "total_pages": 1, "total_entries": 1}`)
+	})
+	mux.HandleFunc("POST /v2/projects/14307913/task_assignments", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": 155505016, "is_active": true, "task": {"id": 8083800, "name": "Research"}}`)
+	})
+	c := newTestClient(t, mux)
+
+	standard := []StandardTask{{Name: " graphic design "}, {Name: "Research"}, {Name: "research"}}
+	changes, err := c.Projects.EnsureStandardTasks(context.Background(), standard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := listed.Load(); n != 1 {
+		t.Errorf("listed tasks %d times, want 1", n)
+	}
+	if !slices.Equal(created, []string{"Research"}) {
+		t.Errorf("created tasks %q, want only Research", created)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("got %d project changes, want 1", len(changes))
+	}
+	if c := changes[0]; c.Err != nil || !slices.Equal(c.Created, []string{"Research"}) || c.Updated != nil || c.Deactivated != nil {
+		t.Errorf("changes = %+v, want only Research assigned", c)
+	}
+}
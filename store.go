@@ -0,0 +1,131 @@
+package harvest
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Store is a local mirror of one Harvest resource type, keyed by its ID,
+// that applications can read from instead of calling the API. Keep it
+// current with Fill, using ListOptions.UpdatedSince for incremental
+// updates.
+//
+// Experimental: this API may change in minor releases.
+type Store[K ~int64, T any] interface {
+	// Upsert inserts items or replaces the stored items with the same IDs.
+	Upsert(ctx context.Context, items ...T) error
+	// Delete removes the items with the given IDs. Missing IDs are ignored.
+	Delete(ctx context.Context, ids ...K) error
+	// Query returns the stored items for which match returns true, ordered
+	// by ID. A nil match returns every item.
+	Query(ctx context.Context, match func(T) bool) ([]T, error)
+}
+
+// StampedStore is a Store that also records when it was last filled with a
+// complete listing, so caches persisted in it, such as a persistent Index,
+// can tell whether the listing is stale.
+//
+// Experimental: this API may change in minor releases.
+type StampedStore[K ~int64, T any] interface {
+	Store[K, T]
+	// LoadedAt returns the time last passed to SetLoadedAt, or the zero
+	// time if there is none.
+	LoadedAt(ctx context.Context) (time.Time, error)
+	// SetLoadedAt records when the stored items were listed. The zero time
+	// marks them as incomplete.
+	SetLoadedAt(ctx context.Context, t time.Time) error
+}
+
+// storeBatchSize is how many items Fill upserts at a time.
+const storeBatchSize = 100
+
+// Fill upserts every item from seq, such as a service's All iterator, into
+// s in batches. It stops at the first error from seq or s.
+//
+//	projects := harvest.NewMemoryStore(func(p harvest.Project) harvest.ProjectID { return p.ID })
+//	err := harvest.Fill(ctx, projects, client.Projects.All(ctx, nil))
+func Fill[K ~int64, T any](ctx context.Context, s Store[K, T], seq iter.Seq2[T, error]) error {
+	batch := make([]T, 0, storeBatchSize)
+	for item, err := range seq {
+		if err != nil {
+			return err
+		}
+		batch = append(batch, item)
+		if len(batch) == storeBatchSize {
+			if err := s.Upsert(ctx, batch...); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	return s.Upsert(ctx, batch...)
+}
+
+// MemoryStore is a Store held in memory. It is safe for concurrent use.
+type MemoryStore[K ~int64, T any] struct {
+	key      func(T) K
+	mu       sync.RWMutex
+	items    map[K]T
+	loadedAt time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore that identifies items by key.
+func NewMemoryStore[K ~int64, T any](key func(T) K) *MemoryStore[K, T] {
+	return &MemoryStore[K, T]{key: key, items: make(map[K]T)}
+}
+
+// Upsert implements Store.
+func (s *MemoryStore[K, T]) Upsert(ctx context.Context, items ...T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, item := range items {
+		s.items[s.key(item)] = item
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore[K, T]) Delete(ctx context.Context, ids ...K) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		delete(s.items, id)
+	}
+	return nil
+}
+
+// Query implements Store.
+func (s *MemoryStore[K, T]) Query(ctx context.Context, match func(T) bool) ([]T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var result []T
+	for _, id := range slices.SortedFunc(maps.Keys(s.items), cmp.Compare[K]) {
+		if item := s.items[id]; match == nil || match(item) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// LoadedAt implements StampedStore.
+func (s *MemoryStore[K, T]) LoadedAt(ctx context.Context) (time.Time, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.loadedAt, nil
+}
+
+// SetLoadedAt implements StampedStore.
+func (s *MemoryStore[K, T]) SetLoadedAt(ctx context.Context, t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadedAt = t
+	return nil
+}
@@ -0,0 +1,136 @@
+package harvest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// tableName matches the table names accepted by NewSQLiteStore.
+var tableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLiteStore is a Store kept in a SQLite table, one row per item holding
+// its ID and JSON encoding. It works with any database/sql SQLite driver;
+// open the database with the driver of your choice and pass it to
+// NewSQLiteStore. Queries decode every row and filter in Go.
+type SQLiteStore[K ~int64, T any] struct {
+	db    *sql.DB
+	table string
+	key   func(T) K
+}
+
+// NewSQLiteStore returns a SQLiteStore that keeps items in table, creating
+// the table if needed. Use a separate table for each resource type. The
+// times recorded with SetLoadedAt are kept in the harvest_store_meta table.
+func NewSQLiteStore[K ~int64, T any](ctx context.Context, db *sql.DB, table string, key func(T) K) (*SQLiteStore[K, T], error) {
+	if !tableName.MatchString(table) {
+		return nil, fmt.Errorf("harvest: invalid table name %q", table)
+	}
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id INTEGER PRIMARY KEY, data TEXT NOT NULL)", table)); err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS harvest_store_meta (name TEXT PRIMARY KEY, loaded_at TEXT NOT NULL)"); err != nil {
+		return nil, err
+	}
+	return &SQLiteStore[K, T]{db: db, table: table, key: key}, nil
+}
+
+// Upsert implements Store. The items are written in a single transaction.
+func (s *SQLiteStore[K, T]) Upsert(ctx context.Context, items ...T) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s (id, data) VALUES (?, ?) ON CONFLICT(id) DO UPDATE SET data = excluded.data", s.table))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.ExecContext(ctx, int64(s.key(item)), string(data)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete implements Store. The items are removed in a single transaction.
+func (s *SQLiteStore[K, T]) Delete(ctx context.Context, ids ...K) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = ?", s.table))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, id := range ids {
+		if _, err := stmt.ExecContext(ctx, int64(id)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Query implements Store.
+func (s *SQLiteStore[K, T]) Query(ctx context.Context, match func(T) bool) ([]T, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf("SELECT data FROM %s ORDER BY id", s.table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []T
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var item T
+		if err := json.Unmarshal([]byte(data), &item); err != nil {
+			return nil, err
+		}
+		if match == nil || match(item) {
+			result = append(result, item)
+		}
+	}
+	return result, rows.Err()
+}
+
+// LoadedAt implements StampedStore.
+func (s *SQLiteStore[K, T]) LoadedAt(ctx context.Context) (time.Time, error) {
+	var loadedAt string
+	err := s.db.QueryRowContext(ctx, "SELECT loaded_at FROM harvest_store_meta WHERE name = ?", s.table).Scan(&loadedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339Nano, loadedAt)
+}
+
+// SetLoadedAt implements StampedStore.
+func (s *SQLiteStore[K, T]) SetLoadedAt(ctx context.Context, t time.Time) error {
+	if t.IsZero() {
+		_, err := s.db.ExecContext(ctx, "DELETE FROM harvest_store_meta WHERE name = ?", s.table)
+		return err
+	}
+	_, err := s.db.ExecContext(ctx, "INSERT INTO harvest_store_meta (name, loaded_at) VALUES (?, ?) ON CONFLICT(name) DO UPDATE SET loaded_at = excluded.loaded_at", s.table, t.UTC().Format(time.RFC3339Nano))
+	return err
+}
@@ -3,6 +3,10 @@ package harvest
 import (
 	"context"
 	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/shopspring/decimal"
 )
 
 // TasksService handles communication with the task related
@@ -14,8 +18,7 @@ type TasksService struct {
 // TaskListOptions specifies optional parameters to the List method.
 type TaskListOptions struct {
 	ListOptions
-	IsActive     *bool  `url:"is_active,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	IsActive *bool `url:"is_active,omitempty"`
 }
 
 // TaskList represents a list of tasks.
@@ -24,32 +27,40 @@ type TaskList struct {
 	Paginated[Task]
 }
 
+func (l *TaskList) page() *Paginated[Task] {
+	l.Tasks = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Tasks and Items.
+func (l *TaskList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "tasks", &l.Paginated, &l.Tasks)
+}
+
 // ListPage returns a single page of tasks.
 func (s *TasksService) ListPage(ctx context.Context, opts *TaskListOptions) (*TaskList, error) {
-	u, err := addOptions("tasks", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[Task, TaskList](ctx, s.client, "tasks", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all tasks across all pages.
+func (s *TasksService) List(ctx context.Context, opts *TaskListOptions) ([]Task, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var tasks TaskList
-	_, err = s.client.Do(ctx, req, &tasks)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *TasksService) ListWithTotals(ctx context.Context, opts *TaskListOptions) (*ListResult[Task], error) {
+	if opts == nil {
+		opts = &TaskListOptions{}
 	}
-
-	// Copy tasks to Items for pagination
-	tasks.Items = tasks.Tasks
-
-	return &tasks, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Task, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all tasks across all pages.
-func (s *TasksService) List(ctx context.Context, opts *TaskListOptions) ([]Task, error) {
+// All returns an iterator over all tasks, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *TasksService) All(ctx context.Context, opts *TaskListOptions) iter.Seq2[Task, error] {
 	if opts == nil {
 		opts = &TaskListOptions{}
 	}
@@ -60,38 +71,44 @@ func (s *TasksService) List(ctx context.Context, opts *TaskListOptions) ([]Task,
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allTasks []Task
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allTasks = append(allTasks, result.Tasks...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[Task](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*TaskList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// Stream sends all tasks on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *TasksService) Stream(ctx context.Context, opts *TaskListOptions) (<-chan Task, <-chan error) {
+	if opts == nil {
+		opts = &TaskListOptions{}
 	}
-
-	return allTasks, nil
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // Get retrieves a specific task.
-func (s *TasksService) Get(ctx context.Context, taskID int64) (*Task, error) {
+func (s *TasksService) Get(ctx context.Context, taskID TaskID) (*Task, error) {
 	return Get[Task](ctx, s.client, fmt.Sprintf("tasks/%d", taskID))
 }
 
 // TaskCreateRequest represents a request to create a task.
 type TaskCreateRequest struct {
-	Name              string  `json:"name"`
-	BillableByDefault *bool   `json:"billable_by_default,omitempty"`
-	DefaultHourlyRate float64 `json:"default_hourly_rate,omitempty"`
-	IsDefault         *bool   `json:"is_default,omitempty"`
-	IsActive          *bool   `json:"is_active,omitempty"`
+	Name              string           `json:"name"`
+	BillableByDefault *bool            `json:"billable_by_default,omitempty"`
+	DefaultHourlyRate *decimal.Decimal `json:"default_hourly_rate,omitempty"`
+	IsDefault         *bool            `json:"is_default,omitempty"`
+	IsActive          *bool            `json:"is_active,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *TaskCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.Name != "", "name", "is required")
+	return errs.err()
 }
 
 // Create creates a new task.
@@ -99,21 +116,60 @@ func (s *TasksService) Create(ctx context.Context, task *TaskCreateRequest) (*Ta
 	return Create[Task](ctx, s.client, "tasks", task)
 }
 
+// GetOrCreate returns the task named name, ignoring case and surrounding
+// spaces, or creates it with the settings in defaults when there is none.
+// Archived tasks count as existing, since Harvest rejects a new task with
+// an archived task's name; restore them with Restore. created reports
+// whether the task was created. defaults may be nil, and its Name is
+// replaced by name.
+func (s *TasksService) GetOrCreate(ctx context.Context, name string, defaults *TaskCreateRequest) (task *Task, created bool, err error) {
+	want := strings.TrimSpace(name)
+	for t, err := range s.All(ctx, nil) {
+		if err != nil {
+			return nil, false, err
+		}
+		if strings.EqualFold(strings.TrimSpace(t.Name), want) {
+			return &t, false, nil
+		}
+	}
+
+	var req TaskCreateRequest
+	if defaults != nil {
+		req = *defaults
+	}
+	req.Name = want
+	task, err = s.Create(ctx, &req)
+	if err != nil {
+		return nil, false, err
+	}
+	return task, true, nil
+}
+
 // TaskUpdateRequest represents a request to update a task.
 type TaskUpdateRequest struct {
-	Name              string  `json:"name,omitempty"`
-	BillableByDefault *bool   `json:"billable_by_default,omitempty"`
-	DefaultHourlyRate float64 `json:"default_hourly_rate,omitempty"`
-	IsDefault         *bool   `json:"is_default,omitempty"`
-	IsActive          *bool   `json:"is_active,omitempty"`
+	Name              string                    `json:"name,omitempty"`
+	BillableByDefault *bool                     `json:"billable_by_default,omitempty"`
+	DefaultHourlyRate Nullable[decimal.Decimal] `json:"default_hourly_rate,omitzero"`
+	IsDefault         *bool                     `json:"is_default,omitempty"`
+	IsActive          *bool                     `json:"is_active,omitempty"`
 }
 
 // Update updates a task.
-func (s *TasksService) Update(ctx context.Context, taskID int64, task *TaskUpdateRequest) (*Task, error) {
+func (s *TasksService) Update(ctx context.Context, taskID TaskID, task *TaskUpdateRequest) (*Task, error) {
 	return Update[Task](ctx, s.client, fmt.Sprintf("tasks/%d", taskID), task)
 }
 
+// Archive deactivates a task.
+func (s *TasksService) Archive(ctx context.Context, taskID TaskID) (*Task, error) {
+	return s.Update(ctx, taskID, &TaskUpdateRequest{IsActive: Bool(false)})
+}
+
+// Restore reactivates an archived task.
+func (s *TasksService) Restore(ctx context.Context, taskID TaskID) (*Task, error) {
+	return s.Update(ctx, taskID, &TaskUpdateRequest{IsActive: Bool(true)})
+}
+
 // Delete deletes a task.
-func (s *TasksService) Delete(ctx context.Context, taskID int64) error {
+func (s *TasksService) Delete(ctx context.Context, taskID TaskID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("tasks/%d", taskID))
 }
@@ -3,6 +3,10 @@ package harvest
 import (
 	"context"
 	"fmt"
+	"iter"
+	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // TimeEntriesService handles communication with the time entry related
@@ -14,17 +18,16 @@ type TimeEntriesService struct {
 // TimeEntryListOptions specifies optional parameters to the List method.
 type TimeEntryListOptions struct {
 	ListOptions
-	UserID              int64  `url:"user_id,omitempty"`
-	ClientID            int64  `url:"client_id,omitempty"`
-	ProjectID           int64  `url:"project_id,omitempty"`
-	TaskID              int64  `url:"task_id,omitempty"`
-	ExternalReferenceID string `url:"external_reference_id,omitempty"`
-	IsBilled            *bool  `url:"is_billed,omitempty"`
-	IsRunning           *bool  `url:"is_running,omitempty"`
-	ApprovalStatus      string `url:"approval_status,omitempty"`
-	UpdatedSince        string `url:"updated_since,omitempty"`
-	From                string `url:"from,omitempty"`
-	To                  string `url:"to,omitempty"`
+	UserID              UserID         `url:"user_id,omitempty"`
+	ClientID            ClientID       `url:"client_id,omitempty"`
+	ProjectID           ProjectID      `url:"project_id,omitempty"`
+	TaskID              TaskID         `url:"task_id,omitempty"`
+	ExternalReferenceID string         `url:"external_reference_id,omitempty"`
+	IsBilled            *bool          `url:"is_billed,omitempty"`
+	IsRunning           *bool          `url:"is_running,omitempty"`
+	ApprovalStatus      ApprovalStatus `url:"approval_status,omitempty"`
+	From                string         `url:"from,omitempty"`
+	To                  string         `url:"to,omitempty"`
 }
 
 // TimeEntryList represents a list of time entries.
@@ -33,32 +36,40 @@ type TimeEntryList struct {
 	Paginated[TimeEntry]
 }
 
+func (l *TimeEntryList) page() *Paginated[TimeEntry] {
+	l.TimeEntries = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both TimeEntries and Items.
+func (l *TimeEntryList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "time_entries", &l.Paginated, &l.TimeEntries)
+}
+
 // ListPage returns a single page of time entries.
 func (s *TimeEntriesService) ListPage(ctx context.Context, opts *TimeEntryListOptions) (*TimeEntryList, error) {
-	u, err := addOptions("time_entries", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[TimeEntry, TimeEntryList](ctx, s.client, "time_entries", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all time entries across all pages.
+func (s *TimeEntriesService) List(ctx context.Context, opts *TimeEntryListOptions) ([]TimeEntry, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var entries TimeEntryList
-	_, err = s.client.Do(ctx, req, &entries)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *TimeEntriesService) ListWithTotals(ctx context.Context, opts *TimeEntryListOptions) (*ListResult[TimeEntry], error) {
+	if opts == nil {
+		opts = &TimeEntryListOptions{}
 	}
-
-	// Copy entries to Items for pagination
-	entries.Items = entries.TimeEntries
-
-	return &entries, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[TimeEntry, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all time entries across all pages.
-func (s *TimeEntriesService) List(ctx context.Context, opts *TimeEntryListOptions) ([]TimeEntry, error) {
+// All returns an iterator over all time entries, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *TimeEntriesService) All(ctx context.Context, opts *TimeEntryListOptions) iter.Seq2[TimeEntry, error] {
 	if opts == nil {
 		opts = &TimeEntryListOptions{}
 	}
@@ -69,42 +80,80 @@ func (s *TimeEntriesService) List(ctx context.Context, opts *TimeEntryListOption
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allEntries []TimeEntry
-
-	for {
-		result, err := s.ListPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
+	return all[TimeEntry](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*TimeEntryList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-		allEntries = append(allEntries, result.TimeEntries...)
+// Stream sends all time entries on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *TimeEntriesService) Stream(ctx context.Context, opts *TimeEntryListOptions) (<-chan TimeEntry, <-chan error) {
+	if opts == nil {
+		opts = &TimeEntryListOptions{}
+	}
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
 
-		if !result.HasNextPage() {
-			break
-		}
+// ListBetween returns all time entries spent from from to to, inclusive.
+// Other filters in opts are applied too; its From and To are replaced.
+func (s *TimeEntriesService) ListBetween(ctx context.Context, from, to Date, opts *TimeEntryListOptions) ([]TimeEntry, error) {
+	var errs fieldErrors
+	errs.dateRange(from, to)
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
 
-		opts.Page = *result.NextPage
+	var o TimeEntryListOptions
+	if opts != nil {
+		o = *opts
 	}
+	o.From, o.To = from.String(), to.String()
+	return s.List(ctx, &o)
+}
 
-	return allEntries, nil
+// ListForUserBetween returns all of a user's time entries spent from from
+// to to, inclusive.
+func (s *TimeEntriesService) ListForUserBetween(ctx context.Context, userID UserID, from, to Date, opts *TimeEntryListOptions) ([]TimeEntry, error) {
+	var o TimeEntryListOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.UserID = userID
+	return s.ListBetween(ctx, from, to, &o)
 }
 
 // Get retrieves a specific time entry.
-func (s *TimeEntriesService) Get(ctx context.Context, timeEntryID int64) (*TimeEntry, error) {
+func (s *TimeEntriesService) Get(ctx context.Context, timeEntryID TimeEntryID) (*TimeEntry, error) {
 	return Get[TimeEntry](ctx, s.client, fmt.Sprintf("time_entries/%d", timeEntryID))
 }
 
 // TimeEntryCreateViaDurationRequest represents a request to create a time entry via duration.
 type TimeEntryCreateViaDurationRequest struct {
-	ProjectID         int64                     `json:"project_id"`
-	TaskID            int64                     `json:"task_id"`
+	ProjectID         ProjectID                 `json:"project_id"`
+	TaskID            TaskID                    `json:"task_id"`
 	SpentDate         string                    `json:"spent_date"`
-	Hours             float64                   `json:"hours"`
-	UserID            int64                     `json:"user_id,omitempty"`
+	Hours             decimal.Decimal           `json:"hours"`
+	UserID            UserID                    `json:"user_id,omitempty"`
 	Notes             string                    `json:"notes,omitempty"`
 	ExternalReference *ExternalReferenceRequest `json:"external_reference,omitempty"`
 }
 
+// Validate checks that the required fields are set.
+func (r *TimeEntryCreateViaDurationRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ProjectID != 0, "project_id", "is required")
+	errs.require(r.TaskID != 0, "task_id", "is required")
+	errs.require(r.SpentDate != "", "spent_date", "is required")
+	errs.date(r.SpentDate, "spent_date")
+	errs.require(!r.Hours.IsNegative(), "hours", "must not be negative")
+	return errs.err()
+}
+
 // ExternalReferenceRequest represents an external reference in a request.
 type ExternalReferenceRequest struct {
 	ID        string `json:"id"`
@@ -113,67 +162,309 @@ type ExternalReferenceRequest struct {
 	Permalink string `json:"permalink"`
 }
 
-// CreateViaDuration creates a new time entry via duration.
+// TimeEntryModeError is returned when a time entry is created with the
+// variant the account isn't configured for, before the request is sent.
+// Accounts that track start and end times need CreateViaStartEnd; the
+// others need CreateViaDuration.
+type TimeEntryModeError struct {
+	// WantsTimestampTimers is the account's setting.
+	WantsTimestampTimers bool
+}
+
+func (e *TimeEntryModeError) Error() string {
+	if e.WantsTimestampTimers {
+		return "harvest: account tracks start and end times; use CreateViaStartEnd"
+	}
+	return "harvest: account tracks duration; use CreateViaDuration"
+}
+
+// Unwrap returns ErrValidation.
+func (e *TimeEntryModeError) Unwrap() error {
+	return ErrValidation
+}
+
+// checkTimeEntryMode returns a *TimeEntryModeError if the account's timer
+// setting doesn't match timestamps. If the company can't be retrieved the
+// check is skipped and left to Harvest.
+func (s *TimeEntriesService) checkTimeEntryMode(ctx context.Context, timestamps bool) error {
+	company, err := s.client.Company.Cached(ctx)
+	if err != nil || company.WantsTimestampTimers == timestamps {
+		return nil
+	}
+	return &TimeEntryModeError{WantsTimestampTimers: company.WantsTimestampTimers}
+}
+
+// CreateViaDuration creates a new time entry via duration. It returns a
+// *TimeEntryModeError if the account tracks start and end times.
 func (s *TimeEntriesService) CreateViaDuration(ctx context.Context, entry *TimeEntryCreateViaDurationRequest) (*TimeEntry, error) {
+	if err := s.client.CheckNotes(entry.ProjectID, entry.Notes); err != nil {
+		return nil, err
+	}
+	if err := s.checkTimeEntryMode(ctx, false); err != nil {
+		return nil, err
+	}
 	return Create[TimeEntry](ctx, s.client, "time_entries", entry)
 }
 
 // TimeEntryCreateViaStartEndRequest represents a request to create a time entry via start and end time.
 type TimeEntryCreateViaStartEndRequest struct {
-	ProjectID         int64                     `json:"project_id"`
-	TaskID            int64                     `json:"task_id"`
+	ProjectID         ProjectID                 `json:"project_id"`
+	TaskID            TaskID                    `json:"task_id"`
 	SpentDate         string                    `json:"spent_date"`
 	StartedTime       string                    `json:"started_time"`
 	EndedTime         string                    `json:"ended_time"`
-	UserID            int64                     `json:"user_id,omitempty"`
+	UserID            UserID                    `json:"user_id,omitempty"`
 	Notes             string                    `json:"notes,omitempty"`
 	ExternalReference *ExternalReferenceRequest `json:"external_reference,omitempty"`
 }
 
-// CreateViaStartEnd creates a new time entry via start and end time.
+// Validate checks that the required fields are set.
+func (r *TimeEntryCreateViaStartEndRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.ProjectID != 0, "project_id", "is required")
+	errs.require(r.TaskID != 0, "task_id", "is required")
+	errs.require(r.SpentDate != "", "spent_date", "is required")
+	errs.date(r.SpentDate, "spent_date")
+	return errs.err()
+}
+
+// CreateViaStartEnd creates a new time entry via start and end time. It
+// returns a *TimeEntryModeError if the account tracks duration.
 func (s *TimeEntriesService) CreateViaStartEnd(ctx context.Context, entry *TimeEntryCreateViaStartEndRequest) (*TimeEntry, error) {
+	if err := s.client.CheckNotes(entry.ProjectID, entry.Notes); err != nil {
+		return nil, err
+	}
+	if err := s.checkTimeEntryMode(ctx, true); err != nil {
+		return nil, err
+	}
 	return Create[TimeEntry](ctx, s.client, "time_entries", entry)
 }
 
+// TimeEntryDuration describes a time entry created via duration, with the
+// same Date and decimal types responses use. Send it with
+// CreateViaDuration(ctx, d.Request()).
+type TimeEntryDuration struct {
+	ProjectID         ProjectID
+	TaskID            TaskID
+	SpentDate         Date
+	Hours             decimal.Decimal
+	UserID            UserID
+	Notes             string
+	ExternalReference *ExternalReferenceRequest
+}
+
+// Request returns the create request for d.
+func (d *TimeEntryDuration) Request() *TimeEntryCreateViaDurationRequest {
+	return &TimeEntryCreateViaDurationRequest{
+		ProjectID:         d.ProjectID,
+		TaskID:            d.TaskID,
+		SpentDate:         dateString(d.SpentDate),
+		Hours:             d.Hours,
+		UserID:            d.UserID,
+		Notes:             d.Notes,
+		ExternalReference: d.ExternalReference,
+	}
+}
+
+// TimeEntryStartEnd describes a time entry created via start and end time,
+// with the same Date and TimeOfDay types responses use. Send it with
+// CreateViaStartEnd(ctx, s.Request(company.Clock)).
+type TimeEntryStartEnd struct {
+	ProjectID         ProjectID
+	TaskID            TaskID
+	SpentDate         Date
+	StartedTime       TimeOfDay
+	EndedTime         TimeOfDay
+	UserID            UserID
+	Notes             string
+	ExternalReference *ExternalReferenceRequest
+}
+
+// Request returns the create request for s, formatting its times for the
+// account's clock setting, Company.Clock.
+func (s *TimeEntryStartEnd) Request(clock string) *TimeEntryCreateViaStartEndRequest {
+	return &TimeEntryCreateViaStartEndRequest{
+		ProjectID:         s.ProjectID,
+		TaskID:            s.TaskID,
+		SpentDate:         dateString(s.SpentDate),
+		StartedTime:       s.StartedTime.Format(clock),
+		EndedTime:         s.EndedTime.Format(clock),
+		UserID:            s.UserID,
+		Notes:             s.Notes,
+		ExternalReference: s.ExternalReference,
+	}
+}
+
+// dateString formats d for a request, leaving the zero Date empty so that
+// validation reports it as missing.
+func dateString(d Date) string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.String()
+}
+
 // TimeEntryUpdateRequest represents a request to update a time entry.
 type TimeEntryUpdateRequest struct {
-	ProjectID         int64                     `json:"project_id,omitempty"`
-	TaskID            int64                     `json:"task_id,omitempty"`
+	ProjectID         ProjectID                 `json:"project_id,omitempty"`
+	TaskID            TaskID                    `json:"task_id,omitempty"`
 	SpentDate         string                    `json:"spent_date,omitempty"`
 	StartedTime       string                    `json:"started_time,omitempty"`
 	EndedTime         string                    `json:"ended_time,omitempty"`
-	Hours             float64                   `json:"hours,omitempty"`
-	Notes             string                    `json:"notes,omitempty"`
+	Hours             *decimal.Decimal          `json:"hours,omitempty"`
+	Notes             Nullable[string]          `json:"notes,omitzero"`
 	ExternalReference *ExternalReferenceRequest `json:"external_reference,omitempty"`
 }
 
+// Validate checks that any dates are well formed.
+func (r *TimeEntryUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.date(r.SpentDate, "spent_date")
+	errs.require(!valueOf(r.Hours).IsNegative(), "hours", "must not be negative")
+	return errs.err()
+}
+
 // Update updates a time entry.
-func (s *TimeEntriesService) Update(ctx context.Context, timeEntryID int64, entry *TimeEntryUpdateRequest) (*TimeEntry, error) {
+func (s *TimeEntriesService) Update(ctx context.Context, timeEntryID TimeEntryID, entry *TimeEntryUpdateRequest) (*TimeEntry, error) {
 	return Update[TimeEntry](ctx, s.client, fmt.Sprintf("time_entries/%d", timeEntryID), entry)
 }
 
 // Delete deletes a time entry.
-func (s *TimeEntriesService) Delete(ctx context.Context, timeEntryID int64) error {
+func (s *TimeEntriesService) Delete(ctx context.Context, timeEntryID TimeEntryID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("time_entries/%d", timeEntryID))
 }
 
 // RestartRequest represents a request to restart a time entry.
 type RestartRequest struct {
-	ID int64 `json:"id"`
+	ID TimeEntryID `json:"id"`
 }
 
 // Restart restarts a stopped time entry.
-func (s *TimeEntriesService) Restart(ctx context.Context, timeEntryID int64) (*TimeEntry, error) {
+func (s *TimeEntriesService) Restart(ctx context.Context, timeEntryID TimeEntryID) (*TimeEntry, error) {
 	req := RestartRequest{ID: timeEntryID}
 	return Update[TimeEntry](ctx, s.client, fmt.Sprintf("time_entries/%d/restart", timeEntryID), req)
 }
 
 // Stop stops a running time entry.
-func (s *TimeEntriesService) Stop(ctx context.Context, timeEntryID int64) (*TimeEntry, error) {
+func (s *TimeEntriesService) Stop(ctx context.Context, timeEntryID TimeEntryID) (*TimeEntry, error) {
 	return Update[TimeEntry](ctx, s.client, fmt.Sprintf("time_entries/%d/stop", timeEntryID), nil)
 }
 
+// Duplicate creates a copy of a time entry on newSpentDate for the same
+// user, project, task and notes. Entries with start and end times are
+// copied with the same times, others with the same hours; a running entry
+// is copied with the hours tracked so far, which fails with a
+// *TimeEntryModeError on accounts that track start and end times. The
+// external reference is not copied, since it usually identifies the
+// original piece of work.
+func (s *TimeEntriesService) Duplicate(ctx context.Context, timeEntryID TimeEntryID, newSpentDate Date) (*TimeEntry, error) {
+	entry, err := s.Get(ctx, timeEntryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Project == nil || entry.Task == nil {
+		return nil, fmt.Errorf("harvest: time entry %d has no project or task to copy", timeEntryID)
+	}
+	var userID UserID
+	if entry.User != nil {
+		userID = entry.User.ID
+	}
+
+	if entry.StartedTime != "" && entry.EndedTime != "" {
+		req := &TimeEntryCreateViaStartEndRequest{
+			ProjectID:   entry.Project.ID,
+			TaskID:      entry.Task.ID,
+			SpentDate:   newSpentDate.String(),
+			StartedTime: entry.StartedTime,
+			EndedTime:   entry.EndedTime,
+			UserID:      userID,
+			Notes:       entry.Notes,
+		}
+		return s.CreateViaStartEnd(ctx, req)
+	}
+
+	req := &TimeEntryCreateViaDurationRequest{
+		ProjectID: entry.Project.ID,
+		TaskID:    entry.Task.ID,
+		SpentDate: newSpentDate.String(),
+		Hours:     entry.Hours,
+		UserID:    userID,
+		Notes:     entry.Notes,
+	}
+	return s.CreateViaDuration(ctx, req)
+}
+
 // DeleteExternalReference deletes an external reference from a time entry.
-func (s *TimeEntriesService) DeleteExternalReference(ctx context.Context, timeEntryID int64) error {
+func (s *TimeEntriesService) DeleteExternalReference(ctx context.Context, timeEntryID TimeEntryID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("time_entries/%d/external_reference", timeEntryID))
 }
+
+// HoursToDuration converts decimal hours, as used by Harvest, to a
+// time.Duration, truncated to the nanosecond.
+func HoursToDuration(hours decimal.Decimal) time.Duration {
+	return time.Duration(hours.Mul(decimal.NewFromInt(int64(time.Hour))).IntPart())
+}
+
+// DurationToHours converts d to decimal hours rounded to the given number
+// of places, e.g. 2 for the precision Harvest displays.
+func DurationToHours(d time.Duration, places int32) decimal.Decimal {
+	return decimal.NewFromInt(int64(d)).Div(decimal.NewFromInt(int64(time.Hour))).Round(places)
+}
+
+// Duration returns the tracked time as a time.Duration.
+func (t *TimeEntry) Duration() time.Duration {
+	return HoursToDuration(t.Hours)
+}
+
+// RoundedDuration returns the tracked time after the account's rounding
+// rules as a time.Duration.
+func (t *TimeEntry) RoundedDuration() time.Duration {
+	return HoursToDuration(t.RoundedHours)
+}
+
+// StartedAt combines SpentDate and StartedTime into a time in loc, which
+// should be the user's timezone. It returns false when the entry has no
+// start time, as is the case for accounts that track duration only.
+func (t *TimeEntry) StartedAt(loc *time.Location) (time.Time, bool, error) {
+	return clockTime(t.SpentDate, t.StartedTime, loc)
+}
+
+// StartedTimeOfDay parses StartedTime. It returns false when the entry has
+// no start time.
+func (t *TimeEntry) StartedTimeOfDay() (TimeOfDay, bool, error) {
+	return timeOfDay(t.StartedTime)
+}
+
+// EndedTimeOfDay parses EndedTime. It returns false when the entry has no
+// end time.
+func (t *TimeEntry) EndedTimeOfDay() (TimeOfDay, bool, error) {
+	return timeOfDay(t.EndedTime)
+}
+
+// EndedAt combines SpentDate and EndedTime into a time in loc, which should
+// be the user's timezone. It returns false when the entry has no end time,
+// including while its timer is running.
+func (t *TimeEntry) EndedAt(loc *time.Location) (time.Time, bool, error) {
+	return clockTime(t.SpentDate, t.EndedTime, loc)
+}
+
+// timeOfDay parses an optional Harvest clock time.
+func timeOfDay(clock string) (TimeOfDay, bool, error) {
+	if clock == "" {
+		return TimeOfDay{}, false, nil
+	}
+	t, err := ParseTimeOfDay(clock)
+	return t, err == nil, err
+}
+
+// clockTime combines a date with a Harvest clock time in loc.
+func clockTime(date Date, clock string, loc *time.Location) (time.Time, bool, error) {
+	if clock == "" || date.IsZero() {
+		return time.Time{}, false, nil
+	}
+	t, err := ParseTimeOfDay(clock)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return t.On(date, loc), true, nil
+}
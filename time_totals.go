@@ -0,0 +1,102 @@
+package harvest
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TimeTotals sums a set of time entries.
+type TimeTotals struct {
+	Hours         decimal.Decimal
+	BillableHours decimal.Decimal
+	// BillableAmounts is the billable amount per currency: the rounded
+	// hours of billable entries times their billable rate, as Harvest
+	// invoices them.
+	BillableAmounts map[string]decimal.Decimal
+}
+
+// add adds e to t.
+func (t *TimeTotals) add(e *TimeEntry) {
+	t.Hours = t.Hours.Add(e.Hours)
+	if !e.Billable {
+		return
+	}
+	t.BillableHours = t.BillableHours.Add(e.Hours)
+	if e.BillableRate != nil {
+		if t.BillableAmounts == nil {
+			t.BillableAmounts = make(map[string]decimal.Decimal)
+		}
+		currency := clientCurrency(e.Client)
+		t.BillableAmounts[currency] = t.BillableAmounts[currency].Add(e.RoundedHours.Mul(*e.BillableRate))
+	}
+}
+
+// TotalTime sums all the entries.
+func TotalTime(entries []TimeEntry) TimeTotals {
+	var t TimeTotals
+	for i := range entries {
+		t.add(&entries[i])
+	}
+	return t
+}
+
+// TotalsByDay sums the entries by the date they were spent.
+func TotalsByDay(entries []TimeEntry) map[Date]TimeTotals {
+	return totalsBy(entries, func(e *TimeEntry) (Date, bool) { return e.SpentDate, true })
+}
+
+// TotalsByWeek sums the entries by the first day of the week they were
+// spent in, for weeks starting on weekStart.
+func TotalsByWeek(entries []TimeEntry, weekStart time.Weekday) map[Date]TimeTotals {
+	return totalsBy(entries, func(e *TimeEntry) (Date, bool) { return startOfWeek(e.SpentDate, weekStart), true })
+}
+
+// TotalsByProject sums the entries by project. Entries without a project
+// are left out.
+func TotalsByProject(entries []TimeEntry) map[ProjectID]TimeTotals {
+	return totalsBy(entries, func(e *TimeEntry) (ProjectID, bool) {
+		if e.Project == nil {
+			return 0, false
+		}
+		return e.Project.ID, true
+	})
+}
+
+// TotalsByTask sums the entries by task. Entries without a task are left
+// out.
+func TotalsByTask(entries []TimeEntry) map[TaskID]TimeTotals {
+	return totalsBy(entries, func(e *TimeEntry) (TaskID, bool) {
+		if e.Task == nil {
+			return 0, false
+		}
+		return e.Task.ID, true
+	})
+}
+
+// TotalsByUser sums the entries by user. Entries without a user are left
+// out.
+func TotalsByUser(entries []TimeEntry) map[UserID]TimeTotals {
+	return totalsBy(entries, func(e *TimeEntry) (UserID, bool) {
+		if e.User == nil {
+			return 0, false
+		}
+		return e.User.ID, true
+	})
+}
+
+// totalsBy sums the entries by the key returned for each, skipping those
+// for which ok is false.
+func totalsBy[K comparable](entries []TimeEntry, key func(*TimeEntry) (K, bool)) map[K]TimeTotals {
+	totals := make(map[K]TimeTotals)
+	for i := range entries {
+		k, ok := key(&entries[i])
+		if !ok {
+			continue
+		}
+		t := totals[k]
+		t.add(&entries[i])
+		totals[k] = t
+	}
+	return totals
+}
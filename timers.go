@@ -0,0 +1,91 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNoRunningTimer is returned when a user has no running timer.
+var ErrNoRunningTimer = errors.New("harvest: no running timer")
+
+// timerStartRequest creates a running time entry. It leaves out hours and
+// ended_time, which is how Harvest is told to start a timer.
+type timerStartRequest struct {
+	ProjectID   ProjectID `json:"project_id"`
+	TaskID      TaskID    `json:"task_id"`
+	SpentDate   string    `json:"spent_date"`
+	StartedTime string    `json:"started_time,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+}
+
+// StartTimer starts a timer for the current user on the given project and
+// task, dated today in the local timezone. On accounts that track start and
+// end times the entry starts at the current local time, formatted for the
+// account's clock setting; on duration accounts Harvest runs the timer from
+// zero hours.
+//
+// Experimental: this API may change in minor releases.
+func (s *TimeEntriesService) StartTimer(ctx context.Context, projectID ProjectID, taskID TaskID, notes string) (*TimeEntry, error) {
+	if err := s.client.CheckNotes(projectID, notes); err != nil {
+		return nil, err
+	}
+	company, err := s.client.Company.Cached(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	req := &timerStartRequest{
+		ProjectID: projectID,
+		TaskID:    taskID,
+		SpentDate: DateOf(now).String(),
+		Notes:     notes,
+	}
+	if company.WantsTimestampTimers {
+		req.StartedTime = TimeOfDayOf(now).Format(company.Clock)
+	}
+	return Create[TimeEntry](ctx, s.client, "time_entries", req)
+}
+
+// CurrentTimer returns the running time entry for userID, or
+// ErrNoRunningTimer if none is running.
+//
+// Experimental: this API may change in minor releases.
+func (s *TimeEntriesService) CurrentTimer(ctx context.Context, userID UserID) (*TimeEntry, error) {
+	opts := &TimeEntryListOptions{
+		ListOptions: ListOptions{PerPage: 1, MaxItems: 1},
+		UserID:      userID,
+		IsRunning:   Bool(true),
+	}
+	for entry, err := range s.All(ctx, opts) {
+		if err != nil {
+			return nil, err
+		}
+		return &entry, nil
+	}
+	return nil, ErrNoRunningTimer
+}
+
+// StopCurrent stops the current user's running timer and returns the
+// stopped entry, or ErrNoRunningTimer if none is running.
+//
+// Experimental: this API may change in minor releases.
+func (s *TimeEntriesService) StopCurrent(ctx context.Context) (*TimeEntry, error) {
+	me, err := s.client.Users.Me(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.CurrentTimer(ctx, me.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Stop(ctx, entry.ID)
+}
+
+// ListRunning returns the running time entries of userID, or of everyone
+// on the account when userID is 0. Listing other users' entries needs an
+// administrator or manager token.
+func (s *TimeEntriesService) ListRunning(ctx context.Context, userID UserID) ([]TimeEntry, error) {
+	return s.List(ctx, &TimeEntryListOptions{UserID: userID, IsRunning: Bool(true)})
+}
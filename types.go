@@ -1,6 +1,8 @@
 package harvest
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -8,41 +10,42 @@ import (
 
 // Company represents a company in Harvest.
 type Company struct {
-	BaseURI              string `json:"base_uri"`
-	FullDomain           string `json:"full_domain"`
-	Name                 string `json:"name"`
-	IsActive             bool   `json:"is_active"`
-	WeekStartDay         string `json:"week_start_day"`
-	WantsTimestampTimers bool   `json:"wants_timestamp_timers"`
-	TimeFormat           string `json:"time_format"`
-	DateFormat           string `json:"date_format"`
-	PlanType             string `json:"plan_type"`
-	Clock                string `json:"clock"`
-	DecimalSymbol        string `json:"decimal_symbol"`
-	ThousandsSeparator   string `json:"thousands_separator"`
-	ColorScheme          string `json:"color_scheme"`
-	WeeklyCapacity       int    `json:"weekly_capacity"`
-	ExpenseFeature       bool   `json:"expense_feature"`
-	InvoiceFeature       bool   `json:"invoice_feature"`
-	EstimateFeature      bool   `json:"estimate_feature"`
-	ApprovalFeature      bool   `json:"approval_feature"`
+	BaseURI              string       `json:"base_uri"`
+	FullDomain           string       `json:"full_domain"`
+	Name                 string       `json:"name"`
+	IsActive             bool         `json:"is_active"`
+	WeekStartDay         WeekStartDay `json:"week_start_day"`
+	WantsTimestampTimers bool         `json:"wants_timestamp_timers"`
+	TimeFormat           string       `json:"time_format"`
+	DateFormat           string       `json:"date_format"`
+	PlanType             string       `json:"plan_type"`
+	Clock                string       `json:"clock"`
+	DecimalSymbol        string       `json:"decimal_symbol"`
+	ThousandsSeparator   string       `json:"thousands_separator"`
+	ColorScheme          string       `json:"color_scheme"`
+	WeeklyCapacity       int          `json:"weekly_capacity"`
+	ExpenseFeature       bool         `json:"expense_feature"`
+	InvoiceFeature       bool         `json:"invoice_feature"`
+	EstimateFeature      bool         `json:"estimate_feature"`
+	ApprovalFeature      bool         `json:"approval_feature"`
 }
 
 // Client represents a client in Harvest.
 type Client struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	IsActive  bool      `json:"is_active"`
-	Address   string    `json:"address,omitempty"`
-	Currency  string    `json:"currency,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           ClientID  `json:"id"`
+	Name         string    `json:"name"`
+	IsActive     bool      `json:"is_active"`
+	Address      string    `json:"address,omitempty"`
+	Currency     string    `json:"currency,omitempty"`
+	StatementKey string    `json:"statement_key,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 // Contact represents a client contact in Harvest.
 type Contact struct {
-	ID          int64     `json:"id"`
-	ClientID    int64     `json:"client_id"`
+	ID          ContactID `json:"id"`
+	ClientID    ClientID  `json:"client_id"`
 	Client      *Client   `json:"client,omitempty"`
 	Title       string    `json:"title,omitempty"`
 	FirstName   string    `json:"first_name"`
@@ -57,16 +60,16 @@ type Contact struct {
 
 // Project represents a project in Harvest.
 type Project struct {
-	ID                               int64            `json:"id"`
+	ID                               ProjectID        `json:"id"`
 	Client                           *Client          `json:"client"`
 	Name                             string           `json:"name"`
 	Code                             string           `json:"code,omitempty"`
 	IsActive                         bool             `json:"is_active"`
 	IsBillable                       bool             `json:"is_billable"`
 	IsFixedFee                       bool             `json:"is_fixed_fee"`
-	BillBy                           string           `json:"bill_by"`
+	BillBy                           BillBy           `json:"bill_by"`
 	Budget                           *decimal.Decimal `json:"budget,omitempty"`
-	BudgetBy                         string           `json:"budget_by,omitempty"`
+	BudgetBy                         BudgetBy         `json:"budget_by,omitempty"`
 	BudgetIsMonthly                  bool             `json:"budget_is_monthly"`
 	NotifyWhenOverBudget             bool             `json:"notify_when_over_budget"`
 	OverBudgetNotificationPercentage decimal.Decimal  `json:"over_budget_notification_percentage,omitempty"`
@@ -80,11 +83,14 @@ type Project struct {
 	EndsOn                           *Date            `json:"ends_on,omitempty"`
 	CreatedAt                        time.Time        `json:"created_at"`
 	UpdatedAt                        time.Time        `json:"updated_at"`
+	// Extra holds response fields not modeled above, keyed by JSON name.
+	// It is only populated by clients created with WithExtraFields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ProjectUserAssignment represents a user assignment to a project.
 type ProjectUserAssignment struct {
-	ID               int64            `json:"id"`
+	ID               UserAssignmentID `json:"id"`
 	Project          *Project         `json:"project"`
 	User             *User            `json:"user"`
 	IsActive         bool             `json:"is_active"`
@@ -98,7 +104,7 @@ type ProjectUserAssignment struct {
 
 // ProjectTaskAssignment represents a task assignment to a project.
 type ProjectTaskAssignment struct {
-	ID         int64            `json:"id"`
+	ID         TaskAssignmentID `json:"id"`
 	Project    *Project         `json:"project"`
 	Task       *Task            `json:"task"`
 	IsActive   bool             `json:"is_active"`
@@ -111,7 +117,7 @@ type ProjectTaskAssignment struct {
 
 // User represents a user in Harvest.
 type User struct {
-	ID                           int64            `json:"id"`
+	ID                           UserID           `json:"id"`
 	FirstName                    string           `json:"first_name"`
 	LastName                     string           `json:"last_name"`
 	Email                        string           `json:"email"`
@@ -124,14 +130,29 @@ type User struct {
 	DefaultHourlyRate            *decimal.Decimal `json:"default_hourly_rate,omitempty"`
 	CostRate                     *decimal.Decimal `json:"cost_rate,omitempty"`
 	Roles                        []string         `json:"roles"`
+	AccessRoles                  []AccessRole     `json:"access_roles"`
 	AvatarURL                    string           `json:"avatar_url"`
 	CreatedAt                    time.Time        `json:"created_at"`
 	UpdatedAt                    time.Time        `json:"updated_at"`
+	// Name is the full name Harvest sends in place of FirstName and LastName
+	// for users nested in other resources, such as time entries.
+	Name string `json:"name,omitempty"`
+	// Extra holds response fields not modeled above, keyed by JSON name.
+	// It is only populated by clients created with WithExtraFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Teammate is a user managed by another user.
+type Teammate struct {
+	ID        UserID `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
 }
 
 // Task represents a task in Harvest.
 type Task struct {
-	ID                int64            `json:"id"`
+	ID                TaskID           `json:"id"`
 	Name              string           `json:"name"`
 	BillableByDefault bool             `json:"billable_by_default"`
 	DefaultHourlyRate *decimal.Decimal `json:"default_hourly_rate,omitempty"`
@@ -143,7 +164,7 @@ type Task struct {
 
 // TimeEntry represents a time entry in Harvest.
 type TimeEntry struct {
-	ID                int64                  `json:"id"`
+	ID                TimeEntryID            `json:"id"`
 	SpentDate         Date                   `json:"spent_date"`
 	User              *User                  `json:"user"`
 	Client            *Client                `json:"client"`
@@ -159,6 +180,7 @@ type TimeEntry struct {
 	LockedReason      string                 `json:"locked_reason,omitempty"`
 	IsClosed          bool                   `json:"is_closed"`
 	IsBilled          bool                   `json:"is_billed"`
+	ApprovalStatus    ApprovalStatus         `json:"approval_status,omitempty"`
 	TimerStartedAt    *time.Time             `json:"timer_started_at,omitempty"`
 	StartedTime       string                 `json:"started_time,omitempty"`
 	EndedTime         string                 `json:"ended_time,omitempty"`
@@ -170,6 +192,14 @@ type TimeEntry struct {
 	CreatedAt         time.Time              `json:"created_at"`
 	UpdatedAt         time.Time              `json:"updated_at"`
 	ExternalReference *ExternalReference     `json:"external_reference,omitempty"`
+
+	// Unavailable lists the optional relations, such as "user_assignment",
+	// that were missing from the response. See UnmarshalJSON.
+	Unavailable []string `json:"-"`
+
+	// Extra holds response fields not modeled above, keyed by JSON name.
+	// It is only populated by clients created with WithExtraFields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // ExternalReference represents an external reference for a time entry.
@@ -184,8 +214,9 @@ type ExternalReference struct {
 
 // Invoice represents an invoice in Harvest.
 type Invoice struct {
-	ID                 int64            `json:"id"`
+	ID                 InvoiceID        `json:"id"`
 	Client             *Client          `json:"client"`
+	ClientKey          string           `json:"client_key"`
 	LineItems          []InvoiceItem    `json:"line_items"`
 	Estimate           *Estimate        `json:"estimate,omitempty"`
 	Number             string           `json:"number"`
@@ -201,25 +232,36 @@ type Invoice struct {
 	Subject            string           `json:"subject,omitempty"`
 	Notes              string           `json:"notes,omitempty"`
 	Currency           string           `json:"currency"`
-	State              string           `json:"state"`
+	State              InvoiceState     `json:"state"`
 	PeriodStart        *Date            `json:"period_start,omitempty"`
 	PeriodEnd          *Date            `json:"period_end,omitempty"`
 	IssueDate          Date             `json:"issue_date"`
 	DueDate            *Date            `json:"due_date,omitempty"`
 	PaymentTerm        string           `json:"payment_term,omitempty"`
+	PaymentOptions     []PaymentOption  `json:"payment_options,omitempty"`
+	Creator            *InvoiceCreator  `json:"creator,omitempty"`
 	SentAt             *time.Time       `json:"sent_at,omitempty"`
 	PaidAt             *time.Time       `json:"paid_at,omitempty"`
 	ClosedAt           *time.Time       `json:"closed_at,omitempty"`
 	RecurringInvoiceID *int64           `json:"recurring_invoice_id,omitempty"`
 	CreatedAt          time.Time        `json:"created_at"`
 	UpdatedAt          time.Time        `json:"updated_at"`
+	// Extra holds response fields not modeled above, keyed by JSON name.
+	// It is only populated by clients created with WithExtraFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// InvoiceCreator identifies the user who created an invoice.
+type InvoiceCreator struct {
+	ID   UserID `json:"id"`
+	Name string `json:"name"`
 }
 
 // InvoiceItem represents a line item on an invoice.
 type InvoiceItem struct {
 	ID          int64           `json:"id"`
 	Project     *Project        `json:"project,omitempty"`
-	Kind        string          `json:"kind"`
+	Kind        LineItemKind    `json:"kind"`
 	Description string          `json:"description"`
 	Quantity    decimal.Decimal `json:"quantity"`
 	UnitPrice   decimal.Decimal `json:"unit_price"`
@@ -230,38 +272,52 @@ type InvoiceItem struct {
 
 // InvoiceMessage represents a message associated with an invoice.
 type InvoiceMessage struct {
-	ID                         int64     `json:"id"`
-	SentBy                     string    `json:"sent_by"`
-	SentByEmail                string    `json:"sent_by_email"`
-	SentFrom                   string    `json:"sent_from"`
-	SentFromEmail              string    `json:"sent_from_email"`
-	IncludeLinkToClientInvoice bool      `json:"include_link_to_client_invoice"`
-	SendMeACopy                bool      `json:"send_me_a_copy"`
-	ThankYou                   bool      `json:"thank_you"`
-	Reminder                   bool      `json:"reminder"`
-	SendReminderOn             *Date     `json:"send_reminder_on"`
-	CreatedAt                  time.Time `json:"created_at"`
-	UpdatedAt                  time.Time `json:"updated_at"`
-	AttachPDF                  bool      `json:"attach_pdf"`
-	EventType                  string    `json:"event_type"`
-	Recipients                 []string  `json:"recipients"`
-	Subject                    *string   `json:"subject"`
-	Body                       *string   `json:"body"`
+	ID                         InvoiceMessageID `json:"id"`
+	SentBy                     string           `json:"sent_by"`
+	SentByEmail                string           `json:"sent_by_email"`
+	SentFrom                   string           `json:"sent_from"`
+	SentFromEmail              string           `json:"sent_from_email"`
+	IncludeLinkToClientInvoice bool             `json:"include_link_to_client_invoice"`
+	SendMeACopy                bool             `json:"send_me_a_copy"`
+	ThankYou                   bool             `json:"thank_you"`
+	Reminder                   bool             `json:"reminder"`
+	SendReminderOn             *Date            `json:"send_reminder_on"`
+	CreatedAt                  time.Time        `json:"created_at"`
+	UpdatedAt                  time.Time        `json:"updated_at"`
+	AttachPDF                  bool             `json:"attach_pdf"`
+	EventType                  string           `json:"event_type"`
+	Recipients                 []string         `json:"recipients"`
+	Subject                    *string          `json:"subject"`
+	Body                       *string          `json:"body"`
+}
+
+// InvoicePayment represents a payment recorded against an invoice.
+type InvoicePayment struct {
+	ID              InvoicePaymentID `json:"id"`
+	Amount          decimal.Decimal  `json:"amount"`
+	PaidAt          *time.Time       `json:"paid_at"`
+	PaidDate        *Date            `json:"paid_date"`
+	RecordedBy      string           `json:"recorded_by"`
+	RecordedByEmail string           `json:"recorded_by_email"`
+	Notes           string           `json:"notes"`
+	TransactionID   *string          `json:"transaction_id"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
 }
 
 // InvoiceItemCategory represents a category for invoice line items.
 type InvoiceItemCategory struct {
-	ID           int64     `json:"id"`
-	Name         string    `json:"name"`
-	UseAsService bool      `json:"use_as_service"`
-	UseAsExpense bool      `json:"use_as_expense"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           InvoiceItemCategoryID `json:"id"`
+	Name         string                `json:"name"`
+	UseAsService bool                  `json:"use_as_service"`
+	UseAsExpense bool                  `json:"use_as_expense"`
+	CreatedAt    time.Time             `json:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at"`
 }
 
 // Estimate represents an estimate in Harvest.
 type Estimate struct {
-	ID             int64            `json:"id"`
+	ID             EstimateID       `json:"id"`
 	Client         *Client          `json:"client"`
 	LineItems      []EstimateItem   `json:"line_items"`
 	Number         string           `json:"number"`
@@ -276,7 +332,7 @@ type Estimate struct {
 	Subject        string           `json:"subject,omitempty"`
 	Notes          string           `json:"notes,omitempty"`
 	Currency       string           `json:"currency"`
-	State          string           `json:"state"`
+	State          EstimateState    `json:"state"`
 	IssueDate      Date             `json:"issue_date"`
 	SentAt         *time.Time       `json:"sent_at,omitempty"`
 	AcceptedAt     *time.Time       `json:"accepted_at,omitempty"`
@@ -285,11 +341,34 @@ type Estimate struct {
 	UpdatedAt      time.Time        `json:"updated_at"`
 }
 
+// EstimateMessage represents a message sent about an estimate, or an event
+// such as marking it as sent.
+type EstimateMessage struct {
+	ID            EstimateMessageID          `json:"id"`
+	SentBy        string                     `json:"sent_by"`
+	SentByEmail   string                     `json:"sent_by_email"`
+	SentFrom      string                     `json:"sent_from"`
+	SentFromEmail string                     `json:"sent_from_email"`
+	SendMeACopy   bool                       `json:"send_me_a_copy"`
+	EventType     *string                    `json:"event_type"`
+	Recipients    []EstimateMessageRecipient `json:"recipients"`
+	Subject       *string                    `json:"subject"`
+	Body          *string                    `json:"body"`
+	CreatedAt     time.Time                  `json:"created_at"`
+	UpdatedAt     time.Time                  `json:"updated_at"`
+}
+
+// EstimateMessageRecipient is a recipient of an estimate message.
+type EstimateMessageRecipient struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
 // EstimateItem represents a line item on an estimate.
 type EstimateItem struct {
 	ID          int64           `json:"id"`
 	Project     *Project        `json:"project,omitempty"`
-	Kind        string          `json:"kind"`
+	Kind        LineItemKind    `json:"kind"`
 	Description string          `json:"description"`
 	Quantity    decimal.Decimal `json:"quantity"`
 	UnitPrice   decimal.Decimal `json:"unit_price"`
@@ -300,15 +379,15 @@ type EstimateItem struct {
 
 // EstimateItemCategory represents a category for estimate line items.
 type EstimateItemCategory struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        EstimateItemCategoryID `json:"id"`
+	Name      string                 `json:"name"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
 }
 
 // Expense represents an expense in Harvest.
 type Expense struct {
-	ID              int64                  `json:"id"`
+	ID              ExpenseID              `json:"id"`
 	Client          *Client                `json:"client"`
 	Project         *Project               `json:"project"`
 	ExpenseCategory *ExpenseCategory       `json:"expense_category"`
@@ -321,23 +400,28 @@ type Expense struct {
 	LockedReason    string                 `json:"locked_reason,omitempty"`
 	IsClosed        bool                   `json:"is_closed"`
 	IsBilled        bool                   `json:"is_billed"`
+	ApprovalStatus  ApprovalStatus         `json:"approval_status,omitempty"`
 	Billable        bool                   `json:"billable"`
 	SpentDate       Date                   `json:"spent_date"`
 	TotalCost       decimal.Decimal        `json:"total_cost"`
 	Units           *decimal.Decimal       `json:"units,omitempty"`
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
+
+	// Unavailable lists the optional relations, such as "user_assignment",
+	// that were missing from the response. See UnmarshalJSON.
+	Unavailable []string `json:"-"`
 }
 
 // ExpenseCategory represents an expense category.
 type ExpenseCategory struct {
-	ID        int64            `json:"id"`
-	Name      string           `json:"name"`
-	UnitName  string           `json:"unit_name,omitempty"`
-	UnitPrice *decimal.Decimal `json:"unit_price,omitempty"`
-	IsActive  bool             `json:"is_active"`
-	CreatedAt time.Time        `json:"created_at"`
-	UpdatedAt time.Time        `json:"updated_at"`
+	ID        ExpenseCategoryID `json:"id"`
+	Name      string            `json:"name"`
+	UnitName  string            `json:"unit_name,omitempty"`
+	UnitPrice *decimal.Decimal  `json:"unit_price,omitempty"`
+	IsActive  bool              `json:"is_active"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
 }
 
 // Receipt represents a receipt attachment.
@@ -350,9 +434,9 @@ type Receipt struct {
 
 // Role represents a role in Harvest.
 type Role struct {
-	ID        int64     `json:"id"`
+	ID        RoleID    `json:"id"`
 	Name      string    `json:"name"`
-	UserIDs   []int64   `json:"user_ids"`
+	UserIDs   []UserID  `json:"user_ids"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -365,13 +449,16 @@ type Date struct {
 // UnmarshalJSON implements json.Unmarshaler for Date.
 func (d *Date) UnmarshalJSON(b []byte) error {
 	s := string(b)
-	s = s[1 : len(s)-1] // Remove quotes
+	if s == "null" {
+		return nil
+	}
+	s = strings.Trim(s, `"`) // Remove quotes
 
-	if s == "" || s == "null" {
+	if s == "" {
 		return nil
 	}
 
-	t, err := time.Parse("2006-01-02", s)
+	t, err := time.Parse(dateLayout, s)
 	if err != nil {
 		return err
 	}
@@ -385,10 +472,10 @@ func (d Date) MarshalJSON() ([]byte, error) {
 	if d.IsZero() {
 		return []byte("null"), nil
 	}
-	return []byte(`"` + d.Format("2006-01-02") + `"`), nil
+	return []byte(`"` + d.Format(dateLayout) + `"`), nil
 }
 
 // String returns the date as a string in YYYY-MM-DD format.
 func (d Date) String() string {
-	return d.Format("2006-01-02")
+	return d.Format(dateLayout)
 }
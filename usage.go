@@ -0,0 +1,107 @@
+package harvest
+
+import (
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UsageSnapshot summarizes the requests a client has made, for rendering
+// internal ops dashboards.
+type UsageSnapshot struct {
+	Since         time.Time                `json:"since"`
+	Requests      int64                    `json:"requests"`
+	Errors        int64                    `json:"errors"`
+	RateLimitHits int64                    `json:"rate_limit_hits"`
+	LastRate      Rate                     `json:"last_rate"`
+	Endpoints     map[string]EndpointUsage `json:"endpoints"`
+}
+
+// EndpointUsage summarizes the requests made to a single endpoint.
+type EndpointUsage struct {
+	Requests     int64         `json:"requests"`
+	Errors       int64         `json:"errors"`
+	TotalLatency time.Duration `json:"total_latency"`
+}
+
+// AverageLatency returns the mean latency of requests to the endpoint.
+func (u EndpointUsage) AverageLatency() time.Duration {
+	if u.Requests == 0 {
+		return 0
+	}
+	return u.TotalLatency / time.Duration(u.Requests)
+}
+
+// usage accumulates request telemetry for a client.
+type usage struct {
+	mu       sync.Mutex
+	snapshot UsageSnapshot
+}
+
+func newUsage() *usage {
+	return &usage{snapshot: UsageSnapshot{
+		Since:     time.Now(),
+		Endpoints: make(map[string]EndpointUsage),
+	}}
+}
+
+// record adds a completed request to the totals. resp is nil when the
+// request failed before a response was received.
+func (u *usage) record(endpoint string, resp *http.Response, err error, latency time.Duration) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	e := u.snapshot.Endpoints[endpoint]
+	e.Requests++
+	e.TotalLatency += latency
+	u.snapshot.Requests++
+
+	if err != nil {
+		e.Errors++
+		u.snapshot.Errors++
+	}
+	if resp != nil {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			u.snapshot.RateLimitHits++
+		}
+		if rate := ParseRate(resp); rate.Limit > 0 {
+			u.snapshot.LastRate = rate
+		}
+	}
+	u.snapshot.Endpoints[endpoint] = e
+}
+
+// lastRate returns the most recent rate limit seen.
+func (u *usage) lastRate() Rate {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.snapshot.LastRate
+}
+
+// Usage returns a snapshot of the requests made by the client since it was
+// created.
+//
+// Experimental: this API may change in minor releases.
+func (c *API) Usage() UsageSnapshot {
+	c.usage.mu.Lock()
+	defer c.usage.mu.Unlock()
+
+	snapshot := c.usage.snapshot
+	snapshot.Endpoints = maps.Clone(snapshot.Endpoints)
+	return snapshot
+}
+
+// endpointName returns the method and API path of req with numeric IDs
+// replaced by ":id", e.g. "GET projects/:id/user_assignments".
+func (c *API) endpointName(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, c.baseURL.Path)
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if s != "" && strings.Trim(s, "0123456789") == "" {
+			segments[i] = ":id"
+		}
+	}
+	return req.Method + " " + strings.Join(segments, "/")
+}
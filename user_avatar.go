@@ -0,0 +1,43 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DownloadAvatar writes a user's avatar image to w and returns its content
+// type. The image is fetched from the user's AvatarURL, following
+// redirects; the access token isn't sent, since avatars are served from
+// outside the API. It returns an error wrapping ErrNotFound when the user
+// has no avatar URL.
+func (s *UsersService) DownloadAvatar(ctx context.Context, userID UserID, w io.Writer) (string, error) {
+	user, err := s.Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.AvatarURL == "" {
+		return "", fmt.Errorf("%w: avatar for user %d", ErrNotFound, userID)
+	}
+
+	ctx, cancel := s.client.withTimeout(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", user.AvatarURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", s.client.userAgent)
+	resp, err := s.client.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("harvest: downloading avatar for user %d: %s", userID, resp.Status)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return "", err
+	}
+	return resp.Header.Get("Content-Type"), nil
+}
@@ -0,0 +1,71 @@
+package harvest
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// secondsPerHour converts Harvest's capacities, which are in seconds.
+var secondsPerHour = decimal.NewFromInt(3600)
+
+// WeeklyCapacityHours returns the user's weekly capacity in hours.
+// WeeklyCapacity itself is in seconds.
+func (u *User) WeeklyCapacityHours() decimal.Decimal {
+	return decimal.NewFromInt(int64(u.WeeklyCapacity)).Div(secondsPerHour)
+}
+
+// WeeklyCapacityDuration returns the user's weekly capacity as a
+// time.Duration.
+func (u *User) WeeklyCapacityDuration() time.Duration {
+	return time.Duration(u.WeeklyCapacity) * time.Second
+}
+
+// FTE returns the user's weekly capacity as a fraction of standardWeek,
+// e.g. 0.5 for 20 hours against a 40-hour week. It returns zero when
+// standardWeek isn't positive.
+func (u *User) FTE(standardWeek time.Duration) decimal.Decimal {
+	if standardWeek <= 0 {
+		return decimal.Zero
+	}
+	capacity := time.Duration(u.WeeklyCapacity) * time.Second
+	return decimal.NewFromInt(int64(capacity)).Div(decimal.NewFromInt(int64(standardWeek)))
+}
+
+// SetWeeklyCapacity sets the weekly capacity from a duration, rounded to
+// the second.
+func (r *UserCreateRequest) SetWeeklyCapacity(d time.Duration) *UserCreateRequest {
+	r.WeeklyCapacity = capacitySeconds(d)
+	return r
+}
+
+// SetWeeklyCapacityHours sets the weekly capacity from a number of hours,
+// such as 37.5.
+func (r *UserCreateRequest) SetWeeklyCapacityHours(hours decimal.Decimal) *UserCreateRequest {
+	r.WeeklyCapacity = capacityHoursSeconds(hours)
+	return r
+}
+
+// SetWeeklyCapacity sets the weekly capacity from a duration, rounded to
+// the second.
+func (r *UserUpdateRequest) SetWeeklyCapacity(d time.Duration) *UserUpdateRequest {
+	r.WeeklyCapacity = capacitySeconds(d)
+	return r
+}
+
+// SetWeeklyCapacityHours sets the weekly capacity from a number of hours,
+// such as 37.5.
+func (r *UserUpdateRequest) SetWeeklyCapacityHours(hours decimal.Decimal) *UserUpdateRequest {
+	r.WeeklyCapacity = capacityHoursSeconds(hours)
+	return r
+}
+
+// capacitySeconds converts d to whole seconds.
+func capacitySeconds(d time.Duration) int {
+	return int(d.Round(time.Second) / time.Second)
+}
+
+// capacityHoursSeconds converts hours to whole seconds.
+func capacityHoursSeconds(hours decimal.Decimal) int {
+	return int(hours.Mul(secondsPerHour).Round(0).IntPart())
+}
@@ -0,0 +1,28 @@
+package harvest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestUserFTE(t *testing.T) {
+	u := User{WeeklyCapacity: 72000} // 20 hours
+	tests := []struct {
+		week time.Duration
+		want string
+	}{
+		{40 * time.Hour, "0.5"},
+		{20 * time.Hour, "1"},
+		{0, "0"},
+		{-time.Hour, "0"},
+		{500 * time.Millisecond, "144000"},
+	}
+	for _, tt := range tests {
+		got := u.FTE(tt.week)
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("FTE(%v) = %s, want %s", tt.week, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FindByEmail returns the user with the given email address, ignoring
+// case. Users, including archived ones, are listed once on first use and
+// kept in memory until RefreshDirectory is called, so look-ups for a
+// directory sync cost one listing rather than one per user. It returns an
+// error wrapping ErrNotFound when no user has that address.
+func (s *UsersService) FindByEmail(ctx context.Context, email string) (*User, error) {
+	users, err := loadIndexed(ctx, s.client, &s.directory, s.List)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if email != "" && strings.EqualFold(u.Email, email) {
+			return &u, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: user email %q", ErrNotFound, email)
+}
+
+// RefreshDirectory discards the users cached by FindByEmail so the next
+// look-up lists them again, bypassing the store set with PersistDirectory.
+func (s *UsersService) RefreshDirectory() {
+	s.directory.reset()
+}
+
+// PersistDirectory makes FindByEmail save each user listing to store and
+// seed its directory from store when the stored listing is no older than
+// maxAge. A positive maxAge also makes FindByEmail list the users again
+// once the directory it holds is older than that.
+//
+// Experimental: this API may change in minor releases.
+func (s *UsersService) PersistDirectory(store StampedStore[UserID, User], maxAge time.Duration) {
+	s.directory.mu.Lock()
+	defer s.directory.mu.Unlock()
+	s.directory.store = storeListing(store, func(u User) UserID { return u.ID })
+	s.directory.maxAge = maxAge
+}
@@ -0,0 +1,82 @@
+package harvest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// ManagerChanges is what ProvisionManager changed.
+type ManagerChanges struct {
+	User *User
+	// Promoted is true when the user's access role was changed from member
+	// to manager.
+	Promoted bool
+	// AddedTeammates and RemovedTeammates are the users the manager
+	// started and stopped managing.
+	AddedTeammates   []UserID
+	RemovedTeammates []UserID
+	// Teammates is the manager's teammates afterwards.
+	Teammates []Teammate
+}
+
+// ProvisionManager makes a user a manager of exactly teammateIDs. A member
+// is promoted to manager first, keeping any extra manager permissions in
+// their access roles; managers are left as they are. Teammates are only
+// updated when they differ from the current ones. It returns an error
+// wrapping ErrValidation for administrators, who can't have teammates. If
+// updating the teammates fails after a promotion, the changes so far are
+// returned with the error.
+//
+// Experimental: this API may change in minor releases.
+func (s *UsersService) ProvisionManager(ctx context.Context, userID UserID, teammateIDs []UserID) (*ManagerChanges, error) {
+	user, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var errs fieldErrors
+	errs.require(!user.HasAccessRole(AccessRoleAdministrator), "access_roles", "administrators can't have teammates")
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+
+	changes := &ManagerChanges{User: user}
+	if !user.HasAccessRole(AccessRoleManager) {
+		roles := slices.DeleteFunc(slices.Clone(user.AccessRoles), func(r AccessRole) bool { return r == AccessRoleMember })
+		roles = append([]AccessRole{AccessRoleManager}, roles...)
+		user, err = s.Update(ctx, userID, &UserUpdateRequest{AccessRoles: roles})
+		if err != nil {
+			return nil, fmt.Errorf("harvest: promoting user %d: %w", userID, err)
+		}
+		changes.User, changes.Promoted = user, true
+	}
+
+	current, err := s.ListTeammates(ctx, userID, nil)
+	if err != nil {
+		return changes, err
+	}
+	currentIDs := make([]UserID, len(current))
+	for i, t := range current {
+		currentIDs[i] = t.ID
+	}
+	for _, id := range teammateIDs {
+		if !slices.Contains(currentIDs, id) && !slices.Contains(changes.AddedTeammates, id) {
+			changes.AddedTeammates = append(changes.AddedTeammates, id)
+		}
+	}
+	for _, id := range currentIDs {
+		if !slices.Contains(teammateIDs, id) {
+			changes.RemovedTeammates = append(changes.RemovedTeammates, id)
+		}
+	}
+	if len(changes.AddedTeammates) == 0 && len(changes.RemovedTeammates) == 0 {
+		changes.Teammates = current
+		return changes, nil
+	}
+
+	changes.Teammates, err = s.UpdateTeammates(ctx, userID, teammateIDs)
+	if err != nil {
+		return changes, fmt.Errorf("harvest: updating teammates of user %d: %w", userID, err)
+	}
+	return changes, nil
+}
@@ -0,0 +1,125 @@
+package harvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// fakeManagerAPI serves one user and their teammates, recording updates.
+type fakeManagerAPI struct {
+	t           *testing.T
+	roles       []AccessRole
+	teammates   []UserID
+	roleUpdates int
+	teamUpdates int
+}
+
+func (f *fakeManagerAPI) handler() http.Handler {
+	mux := http.NewServeMux()
+	writeUser := func(w http.ResponseWriter) {
+		json.NewEncoder(w).Encode(map[string]any{"id": 1782959, "first_name": "Kim", "access_roles": f.roles})
+	}
+	writeTeammates := func(w http.ResponseWriter) {
+		teammates := make([]map[string]any, len(f.teammates))
+		for i, id := range f.teammates {
+			teammates[i] = map[string]any{"id": id}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"teammates": teammates, "page": 1, "total_pages": 1})
+	}
+	mux.HandleFunc("GET /v2/users/1782959", func(w http.ResponseWriter, r *http.Request) {
+		writeUser(w)
+	})
+	mux.HandleFunc("PATCH /v2/users/1782959", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			AccessRoles []AccessRole `json:"access_roles"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			f.t.Error(err)
+		}
+		f.roles = req.AccessRoles
+		f.roleUpdates++
+		writeUser(w)
+	})
+	mux.HandleFunc("GET /v2/users/1782959/teammates", func(w http.ResponseWriter, r *http.Request) {
+		writeTeammates(w)
+	})
+	mux.HandleFunc("PATCH /v2/users/1782959/teammates", func(w http.ResponseWriter, r *http.Request) {
+		var req TeammatesUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			f.t.Error(err)
+		}
+		f.teammates = req.TeammateIDs
+		f.teamUpdates++
+		writeTeammates(w)
+	})
+	return mux
+}
+
+func teammateIDs(teammates []Teammate) []UserID {
+	ids := make([]UserID, len(teammates))
+	for i, t := range teammates {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestProvisionManagerPromotesAndUpdates(t *testing.T) {
+	api := &fakeManagerAPI{t: t, roles: []AccessRole{AccessRoleMember, AccessRoleTimeAndExpensesManager}, teammates: []UserID{1, 2}}
+	c := newTestClient(t, api.handler())
+
+	changes, err := c.Users.ProvisionManager(context.Background(), 1782959, []UserID{2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changes.Promoted || api.roleUpdates != 1 {
+		t.Errorf("Promoted = %v after %d role updates, want a promotion", changes.Promoted, api.roleUpdates)
+	}
+	if want := []AccessRole{AccessRoleManager, AccessRoleTimeAndExpensesManager}; !slices.Equal(api.roles, want) {
+		t.Errorf("access roles = %v, want %v", api.roles, want)
+	}
+	if !slices.Equal(changes.AddedTeammates, []UserID{3}) || !slices.Equal(changes.RemovedTeammates, []UserID{1}) {
+		t.Errorf("added %v removed %v, want added [3] removed [1]", changes.AddedTeammates, changes.RemovedTeammates)
+	}
+	if got := teammateIDs(changes.Teammates); !slices.Equal(got, []UserID{2, 3}) {
+		t.Errorf("Teammates = %v, want [2 3]", got)
+	}
+	if api.teamUpdates != 1 {
+		t.Errorf("updated teammates %d times, want 1", api.teamUpdates)
+	}
+}
+
+func TestProvisionManagerNoChanges(t *testing.T) {
+	api := &fakeManagerAPI{t: t, roles: []AccessRole{AccessRoleManager}, teammates: []UserID{1, 2}}
+	c := newTestClient(t, api.handler())
+
+	changes, err := c.Users.ProvisionManager(context.Background(), 1782959, []UserID{2, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes.Promoted || api.roleUpdates != 0 || api.teamUpdates != 0 {
+		t.Errorf("made changes to an up-to-date manager: %+v, %d role and %d teammate updates", changes, api.roleUpdates, api.teamUpdates)
+	}
+	if len(changes.AddedTeammates) != 0 || len(changes.RemovedTeammates) != 0 {
+		t.Errorf("added %v removed %v, want no changes", changes.AddedTeammates, changes.RemovedTeammates)
+	}
+	if got := teammateIDs(changes.Teammates); !slices.Equal(got, []UserID{1, 2}) {
+		t.Errorf("Teammates = %v, want [1 2]", got)
+	}
+}
+
+func TestProvisionManagerRejectsAdministrators(t *testing.T) {
+	api := &fakeManagerAPI{t: t, roles: []AccessRole{AccessRoleAdministrator}}
+	c := newTestClient(t, api.handler())
+
+	_, err := c.Users.ProvisionManager(context.Background(), 1782959, []UserID{1})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("err = %v, want a validation error", err)
+	}
+	if api.roleUpdates != 0 || api.teamUpdates != 0 {
+		t.Errorf("updated an administrator: %d role and %d teammate updates", api.roleUpdates, api.teamUpdates)
+	}
+}
@@ -3,20 +3,24 @@ package harvest
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
+	"slices"
+
+	"github.com/shopspring/decimal"
 )
 
 // UsersService handles communication with the user related
 // methods of the Harvest API.
 type UsersService struct {
 	client *API
+
+	directory indexed[User]
 }
 
 // UserListOptions specifies optional parameters to the List method.
 type UserListOptions struct {
 	ListOptions
-	IsActive     *bool  `url:"is_active,omitempty"`
-	UpdatedSince string `url:"updated_since,omitempty"`
+	IsActive *bool `url:"is_active,omitempty"`
 }
 
 // UserList represents a list of users.
@@ -25,33 +29,42 @@ type UserList struct {
 	Paginated[User]
 }
 
+func (l *UserList) page() *Paginated[User] {
+	l.Users = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Users and Items.
+func (l *UserList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "users", &l.Paginated, &l.Users)
+}
+
 // ListPage returns a single page of users.
 func (s *UsersService) ListPage(ctx context.Context, opts *UserListOptions) (*UserList, error) {
-	u, err := addOptions("users", opts)
-	if err != nil {
-		return nil, err
-	}
+	return listPage[User, UserList](ctx, s.client, "users", opts)
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// List returns all users across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *UsersService) List(ctx context.Context, opts *UserListOptions) ([]User, error) {
+	return collect(s.All(ctx, opts))
+}
 
-	var users UserList
-	_, err = s.client.Do(ctx, req, &users)
-	if err != nil {
-		return nil, err
+// ListWithTotals is like List but also returns the totals and rate
+// limit reported by the API.
+func (s *UsersService) ListWithTotals(ctx context.Context, opts *UserListOptions) (*ListResult[User], error) {
+	if opts == nil {
+		opts = &UserListOptions{}
 	}
-
-	// Copy users to Items for pagination
-	users.Items = users.Users
-
-	return &users, nil
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[User, error] {
+		return s.All(ctx, opts)
+	})
 }
 
-// List returns all users across all pages.
+// All returns an iterator over all users, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
 // This endpoint uses cursor-based pagination.
-func (s *UsersService) List(ctx context.Context, opts *UserListOptions) ([]User, error) {
+func (s *UsersService) All(ctx context.Context, opts *UserListOptions) iter.Seq2[User, error] {
 	if opts == nil {
 		opts = &UserListOptions{}
 	}
@@ -60,60 +73,27 @@ func (s *UsersService) List(ctx context.Context, opts *UserListOptions) ([]User,
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allUsers []User
+	return all[User](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*UserList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListPage(ctx, &o)
+	})
+}
 
-	// Fetch first page
-	result, err := s.ListPage(ctx, opts)
-	if err != nil {
-		return nil, err
-	}
-	allUsers = append(allUsers, result.Users...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Parse the URL to get path and query
-			u, err := url.Parse(nextURL)
-			if err != nil {
-				return nil, err
-			}
-			pathAndQuery := u.Path
-			if u.RawQuery != "" {
-				pathAndQuery += "?" + u.RawQuery
-			}
-
-			req, err := s.client.NewRequest(ctx, "GET", pathAndQuery, nil)
-			if err != nil {
-				return nil, err
-			}
-
-			var users UserList
-			_, err = s.client.Do(ctx, req, &users)
-			if err != nil {
-				return nil, err
-			}
-			users.Items = users.Users
-			result = &users
-			allUsers = append(allUsers, users.Users...)
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = s.ListPage(ctx, opts)
-			if err != nil {
-				return nil, err
-			}
-			allUsers = append(allUsers, result.Users...)
-		} else {
-			break
-		}
+// Stream sends all users on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *UsersService) Stream(ctx context.Context, opts *UserListOptions) (<-chan User, <-chan error) {
+	if opts == nil {
+		opts = &UserListOptions{}
 	}
-
-	return allUsers, nil
+	seq := s.All(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
 
 // Get retrieves a specific user.
-func (s *UsersService) Get(ctx context.Context, userID int64) (*User, error) {
+func (s *UsersService) Get(ctx context.Context, userID UserID) (*User, error) {
 	return Get[User](ctx, s.client, fmt.Sprintf("users/%d", userID))
 }
 
@@ -122,20 +102,44 @@ func (s *UsersService) Me(ctx context.Context) (*User, error) {
 	return Get[User](ctx, s.client, "users/me")
 }
 
+// HasAccessRole reports whether the user has role among their access
+// roles.
+func (u *User) HasAccessRole(role AccessRole) bool {
+	return slices.Contains(u.AccessRoles, role)
+}
+
 // UserCreateRequest represents a request to create a user.
 type UserCreateRequest struct {
-	FirstName                    string   `json:"first_name"`
-	LastName                     string   `json:"last_name"`
-	Email                        string   `json:"email"`
-	Telephone                    string   `json:"telephone,omitempty"`
-	Timezone                     string   `json:"timezone,omitempty"`
-	HasAccessToAllFutureProjects *bool    `json:"has_access_to_all_future_projects,omitempty"`
-	IsContractor                 *bool    `json:"is_contractor,omitempty"`
-	IsActive                     *bool    `json:"is_active,omitempty"`
-	WeeklyCapacity               int      `json:"weekly_capacity,omitempty"`
-	DefaultHourlyRate            float64  `json:"default_hourly_rate,omitempty"`
-	CostRate                     float64  `json:"cost_rate,omitempty"`
-	Roles                        []string `json:"roles,omitempty"`
+	FirstName                    string           `json:"first_name"`
+	LastName                     string           `json:"last_name"`
+	Email                        string           `json:"email"`
+	Telephone                    string           `json:"telephone,omitempty"`
+	Timezone                     string           `json:"timezone,omitempty"`
+	HasAccessToAllFutureProjects *bool            `json:"has_access_to_all_future_projects,omitempty"`
+	IsContractor                 *bool            `json:"is_contractor,omitempty"`
+	IsActive                     *bool            `json:"is_active,omitempty"`
+	WeeklyCapacity               int              `json:"weekly_capacity,omitempty"`
+	DefaultHourlyRate            *decimal.Decimal `json:"default_hourly_rate,omitempty"`
+	CostRate                     *decimal.Decimal `json:"cost_rate,omitempty"`
+	Roles                        []string         `json:"roles,omitempty"`
+	AccessRoles                  []AccessRole     `json:"access_roles,omitempty"`
+}
+
+// Validate checks that the required fields are set.
+func (r *UserCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.require(r.FirstName != "", "first_name", "is required")
+	errs.require(r.LastName != "", "last_name", "is required")
+	errs.require(r.Email != "", "email", "is required")
+	errs.accessRoles(r.AccessRoles)
+	return errs.err()
+}
+
+// accessRoles records errors for unknown access roles.
+func (f *fieldErrors) accessRoles(roles []AccessRole) {
+	for i, r := range roles {
+		f.require(r.Valid(), fmt.Sprintf("access_roles[%d]", i), "is not a known access role")
+	}
 }
 
 // Create creates a new user.
@@ -145,34 +149,53 @@ func (s *UsersService) Create(ctx context.Context, user *UserCreateRequest) (*Us
 
 // UserUpdateRequest represents a request to update a user.
 type UserUpdateRequest struct {
-	FirstName                    string   `json:"first_name,omitempty"`
-	LastName                     string   `json:"last_name,omitempty"`
-	Email                        string   `json:"email,omitempty"`
-	Telephone                    string   `json:"telephone,omitempty"`
-	Timezone                     string   `json:"timezone,omitempty"`
-	HasAccessToAllFutureProjects *bool    `json:"has_access_to_all_future_projects,omitempty"`
-	IsContractor                 *bool    `json:"is_contractor,omitempty"`
-	IsActive                     *bool    `json:"is_active,omitempty"`
-	WeeklyCapacity               int      `json:"weekly_capacity,omitempty"`
-	DefaultHourlyRate            float64  `json:"default_hourly_rate,omitempty"`
-	CostRate                     float64  `json:"cost_rate,omitempty"`
-	Roles                        []string `json:"roles,omitempty"`
+	FirstName                    string                    `json:"first_name,omitempty"`
+	LastName                     string                    `json:"last_name,omitempty"`
+	Email                        string                    `json:"email,omitempty"`
+	Telephone                    Nullable[string]          `json:"telephone,omitzero"`
+	Timezone                     string                    `json:"timezone,omitempty"`
+	HasAccessToAllFutureProjects *bool                     `json:"has_access_to_all_future_projects,omitempty"`
+	IsContractor                 *bool                     `json:"is_contractor,omitempty"`
+	IsActive                     *bool                     `json:"is_active,omitempty"`
+	WeeklyCapacity               int                       `json:"weekly_capacity,omitempty"`
+	DefaultHourlyRate            Nullable[decimal.Decimal] `json:"default_hourly_rate,omitzero"`
+	CostRate                     Nullable[decimal.Decimal] `json:"cost_rate,omitzero"`
+	Roles                        []string                  `json:"roles,omitempty"`
+	AccessRoles                  []AccessRole              `json:"access_roles,omitempty"`
+}
+
+// Validate checks that the access roles are known.
+func (r *UserUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.accessRoles(r.AccessRoles)
+	return errs.err()
 }
 
 // Update updates a user.
-func (s *UsersService) Update(ctx context.Context, userID int64, user *UserUpdateRequest) (*User, error) {
+func (s *UsersService) Update(ctx context.Context, userID UserID, user *UserUpdateRequest) (*User, error) {
 	return Update[User](ctx, s.client, fmt.Sprintf("users/%d", userID), user)
 }
 
-// Delete archives a user.
-func (s *UsersService) Delete(ctx context.Context, userID int64) error {
+// Archive deactivates a user, who can then no longer sign in. Their time
+// entries and expenses are kept, so this is how to offboard someone.
+func (s *UsersService) Archive(ctx context.Context, userID UserID) (*User, error) {
+	return s.Update(ctx, userID, &UserUpdateRequest{IsActive: Bool(false)})
+}
+
+// Restore reactivates an archived user.
+func (s *UsersService) Restore(ctx context.Context, userID UserID) (*User, error) {
+	return s.Update(ctx, userID, &UserUpdateRequest{IsActive: Bool(true)})
+}
+
+// Delete permanently deletes a user. Harvest only allows this for users
+// with no time entries or expenses; use Archive to offboard anyone else.
+func (s *UsersService) Delete(ctx context.Context, userID UserID) error {
 	return Delete(ctx, s.client, fmt.Sprintf("users/%d", userID))
 }
 
 // UserProjectAssignmentListOptions specifies optional parameters for listing user project assignments.
 type UserProjectAssignmentListOptions struct {
 	ListOptions
-	UpdatedSince string `url:"updated_since,omitempty"`
 }
 
 // UserProjectAssignmentList represents a list of user project assignments.
@@ -181,33 +204,42 @@ type UserProjectAssignmentList struct {
 	Paginated[ProjectUserAssignment]
 }
 
-// ListProjectAssignmentsPage returns a single page of project assignments for a user.
-func (s *UsersService) ListProjectAssignmentsPage(ctx context.Context, userID int64, opts *UserProjectAssignmentListOptions) (*UserProjectAssignmentList, error) {
-	u, err := addOptions(fmt.Sprintf("users/%d/project_assignments", userID), opts)
-	if err != nil {
-		return nil, err
-	}
+func (l *UserProjectAssignmentList) page() *Paginated[ProjectUserAssignment] {
+	l.ProjectAssignments = l.Items
+	return &l.Paginated
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
-	}
+// UnmarshalJSON implements json.Unmarshaler, filling both ProjectAssignments and Items.
+func (l *UserProjectAssignmentList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "project_assignments", &l.Paginated, &l.ProjectAssignments)
+}
 
-	var assignments UserProjectAssignmentList
-	_, err = s.client.Do(ctx, req, &assignments)
-	if err != nil {
-		return nil, err
-	}
+// ListProjectAssignmentsPage returns a single page of project assignments for a user.
+func (s *UsersService) ListProjectAssignmentsPage(ctx context.Context, userID UserID, opts *UserProjectAssignmentListOptions) (*UserProjectAssignmentList, error) {
+	return listPage[ProjectUserAssignment, UserProjectAssignmentList](ctx, s.client, fmt.Sprintf("users/%d/project_assignments", userID), opts)
+}
 
-	// Copy assignments to Items for pagination
-	assignments.Items = assignments.ProjectAssignments
+// ListProjectAssignments returns all project assignments for a user across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *UsersService) ListProjectAssignments(ctx context.Context, userID UserID, opts *UserProjectAssignmentListOptions) ([]ProjectUserAssignment, error) {
+	return collect(s.AllProjectAssignments(ctx, userID, opts))
+}
 
-	return &assignments, nil
+// ListProjectAssignmentsWithTotals is like ListProjectAssignments but also returns the totals and rate
+// limit reported by the API.
+func (s *UsersService) ListProjectAssignmentsWithTotals(ctx context.Context, userID UserID, opts *UserProjectAssignmentListOptions) (*ListResult[ProjectUserAssignment], error) {
+	if opts == nil {
+		opts = &UserProjectAssignmentListOptions{}
+	}
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[ProjectUserAssignment, error] {
+		return s.AllProjectAssignments(ctx, userID, opts)
+	})
 }
 
-// ListProjectAssignments returns all project assignments for a user across all pages.
+// AllProjectAssignments returns an iterator over all project assignments for a user, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
 // This endpoint uses cursor-based pagination.
-func (s *UsersService) ListProjectAssignments(ctx context.Context, userID int64, opts *UserProjectAssignmentListOptions) ([]ProjectUserAssignment, error) {
+func (s *UsersService) AllProjectAssignments(ctx context.Context, userID UserID, opts *UserProjectAssignmentListOptions) iter.Seq2[ProjectUserAssignment, error] {
 	if opts == nil {
 		opts = &UserProjectAssignmentListOptions{}
 	}
@@ -216,84 +248,142 @@ func (s *UsersService) ListProjectAssignments(ctx context.Context, userID int64,
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allAssignments []ProjectUserAssignment
+	return all[ProjectUserAssignment](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*UserProjectAssignmentList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListProjectAssignmentsPage(ctx, userID, &o)
+	})
+}
 
-	// Fetch first page
-	result, err := s.ListProjectAssignmentsPage(ctx, userID, opts)
-	if err != nil {
-		return nil, err
-	}
-	allAssignments = append(allAssignments, result.ProjectAssignments...)
-
-	// Continue fetching remaining pages
-	for result.HasNextPage() {
-		// Check if using cursor-based pagination
-		if nextURL := result.GetNextPageURL(); nextURL != "" {
-			// Parse the URL to get path and query
-			u, err := url.Parse(nextURL)
-			if err != nil {
-				return nil, err
-			}
-			pathAndQuery := u.Path
-			if u.RawQuery != "" {
-				pathAndQuery += "?" + u.RawQuery
-			}
-
-			req, err := s.client.NewRequest(ctx, "GET", pathAndQuery, nil)
-			if err != nil {
-				return nil, err
-			}
-
-			var assignments UserProjectAssignmentList
-			_, err = s.client.Do(ctx, req, &assignments)
-			if err != nil {
-				return nil, err
-			}
-			assignments.Items = assignments.ProjectAssignments
-			result = &assignments
-			allAssignments = append(allAssignments, assignments.ProjectAssignments...)
-		} else if result.NextPage != nil {
-			// Use page-based pagination
-			opts.Page = *result.NextPage
-			result, err = s.ListProjectAssignmentsPage(ctx, userID, opts)
-			if err != nil {
-				return nil, err
-			}
-			allAssignments = append(allAssignments, result.ProjectAssignments...)
-		} else {
-			break
-		}
+// StreamProjectAssignments sends all project assignments for a user on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *UsersService) StreamProjectAssignments(ctx context.Context, userID UserID, opts *UserProjectAssignmentListOptions) (<-chan ProjectUserAssignment, <-chan error) {
+	if opts == nil {
+		opts = &UserProjectAssignmentListOptions{}
 	}
+	seq := s.AllProjectAssignments(ctx, userID, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
 
-	return allAssignments, nil
+// UserTeammateListOptions specifies optional parameters for listing a user's teammates.
+type UserTeammateListOptions struct {
+	ListOptions
 }
 
-// ListMyProjectAssignmentsPage returns a single page of project assignments for the currently authenticated user.
-func (s *UsersService) ListMyProjectAssignmentsPage(ctx context.Context, opts *UserProjectAssignmentListOptions) (*UserProjectAssignmentList, error) {
-	u, err := addOptions("users/me/project_assignments", opts)
-	if err != nil {
-		return nil, err
+// UserTeammateList represents a list of a user's teammates.
+type UserTeammateList struct {
+	Teammates []Teammate `json:"teammates"`
+	Paginated[Teammate]
+}
+
+func (l *UserTeammateList) page() *Paginated[Teammate] {
+	l.Teammates = l.Items
+	return &l.Paginated
+}
+
+// UnmarshalJSON implements json.Unmarshaler, filling both Teammates and Items.
+func (l *UserTeammateList) UnmarshalJSON(data []byte) error {
+	return unmarshalPageKey(data, "teammates", &l.Paginated, &l.Teammates)
+}
+
+// ListTeammatesPage returns a single page of the users managed by a user.
+func (s *UsersService) ListTeammatesPage(ctx context.Context, userID UserID, opts *UserTeammateListOptions) (*UserTeammateList, error) {
+	return listPage[Teammate, UserTeammateList](ctx, s.client, fmt.Sprintf("users/%d/teammates", userID), opts)
+}
+
+// ListTeammates returns all users managed by a user across all pages.
+// This endpoint uses cursor-based pagination.
+func (s *UsersService) ListTeammates(ctx context.Context, userID UserID, opts *UserTeammateListOptions) ([]Teammate, error) {
+	return collect(s.AllTeammates(ctx, userID, opts))
+}
+
+// ListTeammatesWithTotals is like ListTeammates but also returns the totals and rate
+// limit reported by the API.
+func (s *UsersService) ListTeammatesWithTotals(ctx context.Context, userID UserID, opts *UserTeammateListOptions) (*ListResult[Teammate], error) {
+	if opts == nil {
+		opts = &UserTeammateListOptions{}
 	}
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[Teammate, error] {
+		return s.AllTeammates(ctx, userID, opts)
+	})
+}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
-	if err != nil {
-		return nil, err
+// AllTeammates returns an iterator over all users managed by a user, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+// This endpoint uses cursor-based pagination.
+func (s *UsersService) AllTeammates(ctx context.Context, userID UserID, opts *UserTeammateListOptions) iter.Seq2[Teammate, error] {
+	if opts == nil {
+		opts = &UserTeammateListOptions{}
 	}
+	// Don't set Page - it's deprecated for cursor-based pagination
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultPerPage
+	}
+
+	return all[Teammate](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*UserTeammateList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListTeammatesPage(ctx, userID, &o)
+	})
+}
+
+// StreamTeammates sends all users managed by a user on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *UsersService) StreamTeammates(ctx context.Context, userID UserID, opts *UserTeammateListOptions) (<-chan Teammate, <-chan error) {
+	if opts == nil {
+		opts = &UserTeammateListOptions{}
+	}
+	seq := s.AllTeammates(ctx, userID, opts)
+	return stream(ctx, seq, opts.PerPage)
+}
+
+// TeammatesUpdateRequest represents a request to set the users managed by a user.
+type TeammatesUpdateRequest struct {
+	TeammateIDs []UserID `json:"teammate_ids"`
+}
 
-	var assignments UserProjectAssignmentList
-	_, err = s.client.Do(ctx, req, &assignments)
+// UpdateTeammates replaces the users managed by a user with teammateIDs and
+// returns the new list. The user must be a manager. Passing no IDs removes
+// all teammates.
+func (s *UsersService) UpdateTeammates(ctx context.Context, userID UserID, teammateIDs []UserID) ([]Teammate, error) {
+	if teammateIDs == nil {
+		teammateIDs = []UserID{}
+	}
+	list, err := Update[UserTeammateList](ctx, s.client, fmt.Sprintf("users/%d/teammates", userID), &TeammatesUpdateRequest{TeammateIDs: teammateIDs})
 	if err != nil {
 		return nil, err
 	}
+	return list.Teammates, nil
+}
 
-	// Copy assignments to Items for pagination
-	assignments.Items = assignments.ProjectAssignments
-
-	return &assignments, nil
+// ListMyProjectAssignmentsPage returns a single page of project assignments for the currently authenticated user.
+func (s *UsersService) ListMyProjectAssignmentsPage(ctx context.Context, opts *UserProjectAssignmentListOptions) (*UserProjectAssignmentList, error) {
+	return listPage[ProjectUserAssignment, UserProjectAssignmentList](ctx, s.client, "users/me/project_assignments", opts)
 }
 
 // ListMyProjectAssignments returns all project assignments for the currently authenticated user across all pages.
 func (s *UsersService) ListMyProjectAssignments(ctx context.Context, opts *UserProjectAssignmentListOptions) ([]ProjectUserAssignment, error) {
+	return collect(s.AllMyProjectAssignments(ctx, opts))
+}
+
+// ListMyProjectAssignmentsWithTotals is like ListMyProjectAssignments but also returns the totals and rate
+// limit reported by the API.
+func (s *UsersService) ListMyProjectAssignmentsWithTotals(ctx context.Context, opts *UserProjectAssignmentListOptions) (*ListResult[ProjectUserAssignment], error) {
+	if opts == nil {
+		opts = &UserProjectAssignmentListOptions{}
+	}
+	return collectWithTotals(&opts.ListOptions, func() iter.Seq2[ProjectUserAssignment, error] {
+		return s.AllMyProjectAssignments(ctx, opts)
+	})
+}
+
+// AllMyProjectAssignments returns an iterator over all project assignments for the currently authenticated user, fetching
+// pages as the loop advances. Breaking out of the loop stops further requests.
+func (s *UsersService) AllMyProjectAssignments(ctx context.Context, opts *UserProjectAssignmentListOptions) iter.Seq2[ProjectUserAssignment, error] {
 	if opts == nil {
 		opts = &UserProjectAssignmentListOptions{}
 	}
@@ -304,22 +394,21 @@ func (s *UsersService) ListMyProjectAssignments(ctx context.Context, opts *UserP
 		opts.PerPage = DefaultPerPage
 	}
 
-	var allAssignments []ProjectUserAssignment
-
-	for {
-		result, err := s.ListMyProjectAssignmentsPage(ctx, opts)
-		if err != nil {
-			return nil, err
-		}
-
-		allAssignments = append(allAssignments, result.ProjectAssignments...)
-
-		if !result.HasNextPage() {
-			break
-		}
+	return all[ProjectUserAssignment](ctx, s.client, opts.ListOptions, func(ctx context.Context, page int) (*UserProjectAssignmentList, error) {
+		o := *opts
+		o.Page = page
+		return s.ListMyProjectAssignmentsPage(ctx, &o)
+	})
+}
 
-		opts.Page = *result.NextPage
+// StreamMyProjectAssignments sends all project assignments for the currently authenticated user on the returned channel while
+// fetching pages in the background, buffering up to one page. The error
+// channel receives at most one error; both channels are closed when the
+// listing is done or ctx is cancelled.
+func (s *UsersService) StreamMyProjectAssignments(ctx context.Context, opts *UserProjectAssignmentListOptions) (<-chan ProjectUserAssignment, <-chan error) {
+	if opts == nil {
+		opts = &UserProjectAssignmentListOptions{}
 	}
-
-	return allAssignments, nil
+	seq := s.AllMyProjectAssignments(ctx, opts)
+	return stream(ctx, seq, opts.PerPage)
 }
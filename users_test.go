@@ -0,0 +1,33 @@
+package harvest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestUpdateTeammates(t *testing.T) {
+	var sent TeammatesUpdateRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /v2/users/1782959/teammates", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Error(err)
+		}
+		io.WriteString(w, `{"teammates": [{"id": 1782884, "first_name": "Sam", "last_name": "Lee", "email": "sam@example.com"}], "per_page": 2000, "total_pages": 1, "total_entries": 1, "page": 1}`)
+	})
+	c := newTestClient(t, mux)
+
+	teammates, err := c.Users.UpdateTeammates(context.Background(), 1782959, []UserID{1782884})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(sent.TeammateIDs, []UserID{1782884}) {
+		t.Errorf("sent teammate_ids %v, want [1782884]", sent.TeammateIDs)
+	}
+	if len(teammates) != 1 || teammates[0].ID != 1782884 || teammates[0].Email != "sam@example.com" {
+		t.Errorf("teammates = %+v, want Sam Lee", teammates)
+	}
+}
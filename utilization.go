@@ -0,0 +1,111 @@
+package harvest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// UserUtilization is one user's tracked hours against their capacity over
+// a period.
+type UserUtilization struct {
+	UserID       UserID `json:"user_id"`
+	UserName     string `json:"user_name"`
+	IsContractor bool   `json:"is_contractor"`
+	// CapacityHours is the user's weekly capacity prorated to the period.
+	CapacityHours decimal.Decimal `json:"capacity_hours"`
+	TotalHours    decimal.Decimal `json:"total_hours"`
+	BillableHours decimal.Decimal `json:"billable_hours"`
+	// Utilization is TotalHours as a percentage of CapacityHours and
+	// BillableUtilization is BillableHours as a percentage of it. Both are
+	// nil when the user has no capacity.
+	Utilization         *decimal.Decimal `json:"utilization"`
+	BillableUtilization *decimal.Decimal `json:"billable_utilization"`
+}
+
+// UtilizationReport is the utilization of every active user over a period.
+type UtilizationReport struct {
+	Period Period            `json:"period"`
+	Users  []UserUtilization `json:"users"`
+	// Totals sums the hours and capacity of all users. Its user fields are
+	// empty.
+	Totals UserUtilization `json:"totals"`
+}
+
+// Utilization computes each active user's utilization over p from the team
+// time report and their weekly capacity. Capacity is prorated by the
+// number of days in the period, so a two week period counts twice the
+// weekly capacity. Users who tracked no time are included with zero hours;
+// inactive users are included only if they tracked time in the period.
+// Users are ordered by name.
+//
+// Experimental: this API may change in minor releases.
+func (s *ReportsService) Utilization(ctx context.Context, p Period) (*UtilizationReport, error) {
+	users, err := s.client.Users.List(ctx, &UserListOptions{IsActive: Bool(true)})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := collect(s.AllTimeReports(ctx, &TimeReportsOptions{From: p.From.String(), To: p.To.String()}))
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[UserID]*UserUtilization, len(users))
+	add := func(id UserID, name string, contractor bool, weeklyCapacity int) *UserUtilization {
+		u, ok := byUser[id]
+		if !ok {
+			u = &UserUtilization{
+				UserID:        id,
+				UserName:      name,
+				IsContractor:  contractor,
+				CapacityHours: capacityHours(weeklyCapacity, p.Days()),
+			}
+			byUser[id] = u
+		}
+		return u
+	}
+	for _, u := range users {
+		add(u.ID, strings.TrimSpace(u.FirstName+" "+u.LastName), u.IsContractor, u.WeeklyCapacity)
+	}
+	// Harvest reports one row per currency, so a user may have several.
+	for _, row := range rows {
+		u := add(row.UserID, row.UserName, row.IsContractor, row.WeeklyCapacity)
+		u.TotalHours = u.TotalHours.Add(row.TotalHours)
+		u.BillableHours = u.BillableHours.Add(row.BillableHours)
+	}
+
+	report := &UtilizationReport{Period: p, Users: make([]UserUtilization, 0, len(byUser))}
+	for _, u := range byUser {
+		u.Utilization = percentOf(u.TotalHours, u.CapacityHours)
+		u.BillableUtilization = percentOf(u.BillableHours, u.CapacityHours)
+		report.Users = append(report.Users, *u)
+
+		report.Totals.CapacityHours = report.Totals.CapacityHours.Add(u.CapacityHours)
+		report.Totals.TotalHours = report.Totals.TotalHours.Add(u.TotalHours)
+		report.Totals.BillableHours = report.Totals.BillableHours.Add(u.BillableHours)
+	}
+	report.Totals.Utilization = percentOf(report.Totals.TotalHours, report.Totals.CapacityHours)
+	report.Totals.BillableUtilization = percentOf(report.Totals.BillableHours, report.Totals.CapacityHours)
+	slices.SortFunc(report.Users, func(a, b UserUtilization) int {
+		return cmp.Or(strings.Compare(strings.ToLower(a.UserName), strings.ToLower(b.UserName)), cmp.Compare(a.UserID, b.UserID))
+	})
+	return report, nil
+}
+
+// capacityHours prorates a weekly capacity in seconds to days.
+func capacityHours(weeklyCapacity, days int) decimal.Decimal {
+	return decimal.NewFromInt(int64(weeklyCapacity)).Mul(decimal.NewFromInt(int64(days))).Div(decimal.NewFromInt(3600 * 7))
+}
+
+// percentOf returns part as a percentage of whole, or nil when whole is
+// zero.
+func percentOf(part, whole decimal.Decimal) *decimal.Decimal {
+	if !whole.IsPositive() {
+		return nil
+	}
+	p := part.Div(whole).Mul(decimal.NewFromInt(100))
+	return &p
+}
@@ -0,0 +1,124 @@
+package harvest
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FieldError describes a single invalid field in a request.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when a request fails client-side validation.
+// It wraps ErrValidation, the same sentinel used for 422 responses.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = fmt.Sprintf("%s: %s", err.Field, err.Message)
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns ErrValidation.
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// validator is implemented by request bodies that can be checked before
+// they are sent. Create and Update call Validate automatically.
+type validator interface {
+	Validate() error
+}
+
+// validate runs body's Validate method if it has one.
+func validate(body any) error {
+	v, ok := body.(validator)
+	if !ok {
+		return nil
+	}
+	if rv := reflect.ValueOf(body); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	return v.Validate()
+}
+
+// fieldErrors accumulates FieldErrors for a Validate method.
+type fieldErrors []FieldError
+
+// require records message for field when ok is false.
+func (f *fieldErrors) require(ok bool, field, message string) {
+	if !ok {
+		*f = append(*f, FieldError{Field: field, Message: message})
+	}
+}
+
+// date records an error for field when value is set but not a YYYY-MM-DD date.
+func (f *fieldErrors) date(value, field string) {
+	if value == "" {
+		return
+	}
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		f.require(false, field, "must be a date in YYYY-MM-DD format")
+	}
+}
+
+// percent records an error for field when value is set and outside 0-100.
+func (f *fieldErrors) percent(value *decimal.Decimal, field string) {
+	if value == nil {
+		return
+	}
+	f.require(!value.IsNegative() && value.LessThanOrEqual(decimal.NewFromInt(100)), field, "must be a percentage between 0 and 100")
+}
+
+// dateRange records errors unless from and to are set and to is not
+// before from.
+func (f *fieldErrors) dateRange(from, to Date) {
+	f.require(!from.IsZero(), "from", "is required")
+	f.require(!to.IsZero(), "to", "is required")
+	f.require(!to.BeforeDate(from), "to", "must not be before from")
+}
+
+// lineItem records errors for the ith line item of an invoice or estimate
+// request when it is new but has no kind, or is removed but has no ID.
+func (f *fieldErrors) lineItem(i int, id int64, kind LineItemKind, destroy bool) {
+	switch {
+	case destroy:
+		f.require(id != 0, fmt.Sprintf("line_items[%d].id", i), "is required to remove a line item")
+	case id == 0:
+		f.require(kind != "", fmt.Sprintf("line_items[%d].kind", i), "is required")
+	}
+}
+
+// uniqueFold records an error for field for each key of rates that equals
+// another key ignoring case.
+func (f *fieldErrors) uniqueFold(rates map[string]decimal.Decimal, field string) {
+	seen := make(map[string]string, len(rates))
+	for _, key := range slices.Sorted(maps.Keys(rates)) {
+		folded := strings.ToLower(key)
+		if prev, ok := seen[folded]; ok {
+			f.require(false, field, fmt.Sprintf("%q and %q differ only in case", prev, key))
+			continue
+		}
+		seen[folded] = key
+	}
+}
+
+// err returns a *ValidationError if any errors were recorded.
+func (f fieldErrors) err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: f}
+}